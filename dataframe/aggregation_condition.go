@@ -7,6 +7,12 @@ import (
 type AggregationCondition struct {
 	ColumnName series.Name
 	Method     series.AggregationMethod
+	// NAPolicy decides how NA elements are treated. The zero value skips them.
+	NAPolicy series.NAPolicy
+	// Options are passed to series.Aggregate after NAPolicy
+	Options []series.AggregateOption
+	// Alias is the name of the output column. An aliased column is named plainly without aggregated method.
+	Alias series.Name
 }
 
 func NewAggregationCondition(columnName series.Name, method series.AggregationMethod) AggregationCondition {
@@ -23,3 +29,52 @@ func (ac AggregationCondition) GetMethod() series.AggregationMethod {
 func (ac AggregationCondition) GetColumnName() series.Name {
 	return ac.ColumnName
 }
+
+func (ac AggregationCondition) GetAlias() series.Name {
+	return ac.Alias
+}
+
+// WithAlias returns the condition whose output column is named alias
+func (ac AggregationCondition) WithAlias(alias series.Name) AggregationCondition {
+	ac.Alias = alias
+	return ac
+}
+
+// OutputName returns the name of the output column, which is the alias if given
+func (ac AggregationCondition) OutputName() series.Name {
+	if ac.Alias != "" {
+		return ac.Alias
+	}
+	return ac.ColumnName
+}
+
+// OutputMethod returns the aggregated method of the output column, which is None if aliased
+func (ac AggregationCondition) OutputMethod() series.AggregationMethod {
+	if ac.Alias != "" {
+		return series.None
+	}
+	return ac.Method
+}
+
+func (ac AggregationCondition) GetNAPolicy() series.NAPolicy {
+	return ac.NAPolicy
+}
+
+// WithNAPolicy returns the condition with the policy
+func (ac AggregationCondition) WithNAPolicy(policy series.NAPolicy) AggregationCondition {
+	ac.NAPolicy = policy
+	return ac
+}
+
+// WithOptions returns the condition with the options added
+func (ac AggregationCondition) WithOptions(options ...series.AggregateOption) AggregationCondition {
+	newOptions := make([]series.AggregateOption, 0, len(ac.Options)+len(options))
+	newOptions = append(newOptions, ac.Options...)
+	ac.Options = append(newOptions, options...)
+	return ac
+}
+
+// GetAggregateOptions returns the options to aggregate a series with
+func (ac AggregationCondition) GetAggregateOptions() []series.AggregateOption {
+	return append([]series.AggregateOption{series.WithNAPolicy(ac.NAPolicy)}, ac.Options...)
+}
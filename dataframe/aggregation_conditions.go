@@ -28,6 +28,40 @@ func (acs AggregationConditions) GetConditionByName(name series.Name) (Aggregati
 	return AggregationCondition{}, fmt.Errorf("aggregation condition not found, name: %s", name)
 }
 
+// Append returns conditions with the condition appended. The receiver is not modified.
 func (acs AggregationConditions) Append(ac AggregationCondition) AggregationConditions {
-	return append(acs, ac)
+	newConditions := make(AggregationConditions, acs.Len(), acs.Len()+1)
+	copy(newConditions, acs)
+	return append(newConditions, ac)
+}
+
+// Validate checks that output columns of the conditions do not collide, so that aliases are unique.
+// keyNames are the key columns which lead the aggregated rows of groups, and no alias may be one of them.
+func (acs AggregationConditions) Validate(keyNames ...series.Name) error {
+	for i, ac := range acs {
+		for _, keyName := range keyNames {
+			if ac.Alias == keyName {
+				return fmt.Errorf("alias collides with key column, alias: %s", ac.Alias)
+			}
+		}
+		for _, ac2 := range acs[:i] {
+			if ac.OutputName() == ac2.OutputName() && ac.OutputMethod() == ac2.OutputMethod() {
+				if ac.Alias != "" {
+					return fmt.Errorf("duplicate alias, alias: %s", ac.Alias)
+				}
+				return fmt.Errorf("duplicate aggregation condition, name: %s, method: %s", ac.ColumnName, ac.Method)
+			}
+		}
+	}
+	return nil
+}
+
+// outputsKey reports whether any unaliased condition with None method outputs the key column of the name
+func (acs AggregationConditions) outputsKey(name series.Name) bool {
+	for _, ac := range acs {
+		if ac.Alias == "" && ac.ColumnName == name && ac.Method == series.None {
+			return true
+		}
+	}
+	return false
 }
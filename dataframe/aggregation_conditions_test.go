@@ -89,3 +89,72 @@ func TestAggregationConditions_GetCondition(t *testing.T) {
 
 	}
 }
+
+func TestAggregationConditions_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		AggregationConditions
+		keyNames []series.Name
+		wantErr  bool
+	}{
+		{
+			name: "pass (same column with different aliases)",
+			AggregationConditions: AggregationConditions{
+				NewAggregationCondition("salary", series.Mean).WithAlias("salary_mean"),
+				NewAggregationCondition("salary", series.Max).WithAlias("salary_max"),
+				NewAggregationCondition("salary", series.Max),
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (duplicate alias)",
+			AggregationConditions: AggregationConditions{
+				NewAggregationCondition("salary", series.Mean).WithAlias("salary"),
+				NewAggregationCondition("bonus", series.Mean).WithAlias("salary"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail (alias collides with non aggregated column)",
+			AggregationConditions: AggregationConditions{
+				NewAggregationCondition("department", series.None),
+				NewAggregationCondition("employee", series.Count).WithAlias("department"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "pass (key column is not aliased)",
+			AggregationConditions: AggregationConditions{
+				NewAggregationCondition("department", series.None),
+				NewAggregationCondition("department", series.Count),
+			},
+			keyNames: []series.Name{"department"},
+			wantErr:  false,
+		},
+		{
+			name: "fail (alias collides with key column)",
+			AggregationConditions: AggregationConditions{
+				NewAggregationCondition("employee", series.Count).WithAlias("department"),
+			},
+			keyNames: []series.Name{"department"},
+			wantErr:  true,
+		},
+		{
+			name: "fail (duplicate condition)",
+			AggregationConditions: AggregationConditions{
+				NewAggregationCondition("salary", series.Mean),
+				NewAggregationCondition("salary", series.Mean),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.AggregationConditions.Validate(tt.keyNames...)
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hrbrain/goban/series"
+	"github.com/pkg/errors"
 )
 
 // Columns are a list of series
@@ -42,11 +43,11 @@ func (columns Columns) Names() []series.Name {
 	return columnNames
 }
 
-// Append new series into columns
+// Append new series into columns. The receiver is not modified.
 func (columns Columns) Append(series series.Series) (Columns, error) {
 	// no need to check length when columns are empty
 	if columns.Len() == 0 {
-		return append(columns, series), nil
+		return columns.with(series), nil
 	}
 
 	// check if the length of the series is the same as the length of the columns
@@ -60,7 +61,14 @@ func (columns Columns) Append(series series.Series) (Columns, error) {
 			return nil, fmt.Errorf("duplicate column name and aggregated method, name: %s, aggregatedMethod: %s", series.GetName(), series.GetAggregatedMethod())
 		}
 	}
-	return append(columns, series), nil
+	return columns.with(series), nil
+}
+
+// with returns new columns with the series appended, never writing into the backing array of the receiver
+func (columns Columns) with(s series.Series) Columns {
+	newColumns := make(Columns, columns.Len(), columns.Len()+1)
+	copy(newColumns, columns)
+	return append(newColumns, s)
 }
 
 func (columns Columns) isValidIndex(index int) bool {
@@ -92,28 +100,51 @@ func (columns Columns) FindSeriesBy(name series.Name, method series.AggregationM
 	return series.Series{}, fmt.Errorf("series name not found in columns, name: %s", name)
 }
 
-// Replace column by index.
+// Replace column by index. The receiver is not modified.
 // Length of replacing series and length of other columns can be inconsistent
 func (columns Columns) Replace(index int, series series.Series) (Columns, error) {
 	if !columns.isValidIndex(index) {
 		return nil, fmt.Errorf("index out of range, index: %d", index)
 	}
-	columns[index] = series
-	return columns, nil
+	newColumns := columns.copy()
+	newColumns[index] = series
+	return newColumns, nil
 }
 
-// ReplaceByName replace column by its name.
+// ReplaceByName replace column by its name. The receiver is not modified.
 // Length of replacing series and length of other columns can be inconsistent
 func (columns Columns) ReplaceByName(s series.Series) (Columns, error) {
 	for i, nameInColumns := range columns.Names() {
 		if nameInColumns == s.GetName() {
-			columns[i] = s
-			return columns, nil
+			return columns.Replace(i, s)
 		}
 	}
 	return Columns{}, fmt.Errorf("series name not found, s.Name: %s", s.GetName())
 }
 
+// Clone returns a copy of the columns which shares no elements with the receiver
+func (columns Columns) Clone() (Columns, error) {
+	if columns == nil {
+		return nil, nil
+	}
+	newColumns := make(Columns, columns.Len())
+	for i, s := range columns {
+		cloned, err := s.Clone()
+		if err != nil {
+			return Columns{}, errors.Wrapf(err, "failed to clone series, name: %s", s.GetName())
+		}
+		newColumns[i] = cloned
+	}
+	return newColumns, nil
+}
+
+// copy returns a shallow copy of the columns, so that assigning into it does not affect the receiver
+func (columns Columns) copy() Columns {
+	newColumns := make(Columns, columns.Len())
+	copy(newColumns, columns)
+	return newColumns
+}
+
 // Delete delete data keeping its schema
 func (columns Columns) Delete() (Columns, error) {
 	ss := make([]series.Series, columns.Len())
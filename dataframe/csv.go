@@ -0,0 +1,337 @@
+package dataframe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+	"github.com/pkg/errors"
+)
+
+type readCSVConfig struct {
+	delimiter        rune
+	lazyQuotes       bool
+	trimLeadingSpace bool
+	hasHeaderRow     bool
+	header           []series.Name
+	naTokens         map[string]bool
+	skipRows         int
+}
+
+// ReadCSVOption configures ReadCSV
+type ReadCSVOption func(*readCSVConfig)
+
+// WithDelimiter sets the field delimiter. The default is ','.
+func WithDelimiter(delimiter rune) ReadCSVOption {
+	return func(c *readCSVConfig) {
+		c.delimiter = delimiter
+	}
+}
+
+// WithLazyQuotes allows a quote to appear in an unquoted field and a non-doubled quote in a quoted field
+func WithLazyQuotes() ReadCSVOption {
+	return func(c *readCSVConfig) {
+		c.lazyQuotes = true
+	}
+}
+
+// WithTrimLeadingSpace ignores leading white space in a field
+func WithTrimLeadingSpace() ReadCSVOption {
+	return func(c *readCSVConfig) {
+		c.trimLeadingSpace = true
+	}
+}
+
+// WithHeader overrides the column names read from the header row
+func WithHeader(names ...series.Name) ReadCSVOption {
+	return func(c *readCSVConfig) {
+		c.header = names
+	}
+}
+
+// WithoutHeaderRow treats the first row as data.
+// Columns are named by WithHeader if given, otherwise by their zero based index.
+func WithoutHeaderRow() ReadCSVOption {
+	return func(c *readCSVConfig) {
+		c.hasHeaderRow = false
+	}
+}
+
+// WithNATokens sets additional tokens which are read as NA. Empty cells are always NA.
+func WithNATokens(tokens ...string) ReadCSVOption {
+	return func(c *readCSVConfig) {
+		for _, token := range tokens {
+			c.naTokens[token] = true
+		}
+	}
+}
+
+// WithSkipRows skips the first n rows before reading the header
+func WithSkipRows(n int) ReadCSVOption {
+	return func(c *readCSVConfig) {
+		c.skipRows = n
+	}
+}
+
+// ReadCSV reads csv into a dataframe.
+// A column becomes numeric if all of its non NA cells can be parsed as float, otherwise it becomes string.
+func ReadCSV(r io.Reader, options ...ReadCSVOption) (DataFrame, error) {
+	config := readCSVConfig{
+		delimiter:    ',',
+		hasHeaderRow: true,
+		naTokens:     map[string]bool{"": true},
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	if config.skipRows < 0 {
+		return DataFrame{}, fmt.Errorf("invalid skip rows, skipRows: %d", config.skipRows)
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = config.delimiter
+	reader.LazyQuotes = config.lazyQuotes
+	reader.TrimLeadingSpace = config.trimLeadingSpace
+	// field count is validated after skipping rows
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to read csv")
+	}
+	if config.skipRows > len(rows) {
+		return DataFrame{}, fmt.Errorf("skip rows exceed row count, skipRows: %d, rowCount: %d", config.skipRows, len(rows))
+	}
+	rows = rows[config.skipRows:]
+
+	var headerRow []string
+	if config.hasHeaderRow {
+		if len(rows) == 0 {
+			return DataFrame{}, errors.New("header row not found")
+		}
+		headerRow, rows = rows[0], rows[1:]
+	}
+
+	names, err := config.columnNames(headerRow, rows)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to decide column names")
+	}
+	for i, row := range rows {
+		if len(row) != len(names) {
+			return DataFrame{}, fmt.Errorf("wrong number of fields, row: %d, fieldCount: %d, columnCount: %d", i, len(row), len(names))
+		}
+	}
+
+	columns, err := NewColumns(nil)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	for i, name := range names {
+		cells := make([]string, len(rows))
+		for j, row := range rows {
+			cells[j] = row[i]
+		}
+		s, err := series.NewSeries(name, config.inferElements(cells), series.None)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to make new series")
+		}
+		columns, err = columns.Append(s)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to append column")
+		}
+	}
+	return NewDataFrame(columns), nil
+}
+
+func (c readCSVConfig) columnNames(headerRow []string, rows [][]string) ([]series.Name, error) {
+	if c.header != nil {
+		if headerRow != nil && len(headerRow) != len(c.header) {
+			return nil, fmt.Errorf("header length mismatch, header: %d, headerRow: %d", len(c.header), len(headerRow))
+		}
+		return c.header, nil
+	}
+	if headerRow != nil {
+		names := make([]series.Name, len(headerRow))
+		for i, h := range headerRow {
+			names[i] = series.NewName(h)
+		}
+		return names, nil
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	names := make([]series.Name, len(rows[0]))
+	for i := range names {
+		names[i] = series.NewName(strconv.Itoa(i))
+	}
+	return names, nil
+}
+
+func (c readCSVConfig) isNA(cell string) bool {
+	return c.naTokens[cell]
+}
+
+// inferElements returns a numeric array if all non NA cells are numbers, otherwise string elements.
+// Cells of NaN and Inf are NA in numeric arrays.
+func (c readCSVConfig) inferElements(cells []string) element.Elements {
+	values := make([]float64, len(cells))
+	var validity element.Bitmap
+	hasValue := false
+	for i, cell := range cells {
+		if c.isNA(cell) {
+			if validity == nil {
+				validity = element.NewBitmap(len(cells))
+			}
+			validity.Clear(i)
+			continue
+		}
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return c.stringElements(cells)
+		}
+		// NaN and Inf are NA, because they cannot be encoded into JSON
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			if validity == nil {
+				validity = element.NewBitmap(len(cells))
+			}
+			validity.Clear(i)
+			continue
+		}
+		values[i] = f
+		hasValue = true
+	}
+	if !hasValue {
+		return c.stringElements(cells)
+	}
+	return element.NumericArray{Values: values, Validity: validity}
+}
+
+func (c readCSVConfig) stringElements(cells []string) element.StringElements {
+	stringElements := make(element.StringElements, len(cells))
+	for i, cell := range cells {
+		if c.isNA(cell) {
+			stringElements[i] = element.NewStringElement("", true)
+			continue
+		}
+		stringElements[i] = element.NewStringElement(cell, false)
+	}
+	return stringElements
+}
+
+type writeCSVConfig struct {
+	delimiter     rune
+	naRep         string
+	floatFormat   byte
+	precision     int
+	listSeparator string
+	headerName    func(series.Series) string
+}
+
+// WriteCSVOption configures DataFrame.WriteCSV
+type WriteCSVOption func(*writeCSVConfig)
+
+// WithOutputDelimiter sets the field delimiter. The default is ','.
+func WithOutputDelimiter(delimiter rune) WriteCSVOption {
+	return func(c *writeCSVConfig) {
+		c.delimiter = delimiter
+	}
+}
+
+// WithNARep sets the string written for NA elements. The default is an empty string.
+func WithNARep(naRep string) WriteCSVOption {
+	return func(c *writeCSVConfig) {
+		c.naRep = naRep
+	}
+}
+
+// WithFloatFormat formats numeric elements by strconv.FormatFloat instead of NumericElement.String
+func WithFloatFormat(format byte, precision int) WriteCSVOption {
+	return func(c *writeCSVConfig) {
+		c.floatFormat = format
+		c.precision = precision
+	}
+}
+
+// WithListSeparator sets the separator used to join string list elements. The default is ','.
+func WithListSeparator(separator string) WriteCSVOption {
+	return func(c *writeCSVConfig) {
+		c.listSeparator = separator
+	}
+}
+
+// WithHeaderName overrides how a header is made from a column
+func WithHeaderName(headerName func(series.Series) string) WriteCSVOption {
+	return func(c *writeCSVConfig) {
+		c.headerName = headerName
+	}
+}
+
+// HeaderName returns the column name, suffixed with its aggregated method if the column is aggregated (e.g. salary_Mean)
+func HeaderName(s series.Series) string {
+	if s.GetAggregatedMethod() == series.None {
+		return s.GetName().String()
+	}
+	return fmt.Sprintf("%s_%s", s.GetName(), s.GetAggregatedMethod())
+}
+
+// WriteCSV writes the dataframe as csv keeping the order of columns
+func (df DataFrame) WriteCSV(w io.Writer, options ...WriteCSVOption) error {
+	config := writeCSVConfig{
+		delimiter:     ',',
+		listSeparator: ",",
+		headerName:    HeaderName,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = config.delimiter
+
+	columns := df.GetColumns()
+	row := make([]string, columns.Len())
+	for i, s := range columns {
+		row[i] = config.headerName(s)
+	}
+	if err := writer.Write(row); err != nil {
+		return errors.Wrap(err, "failed to write header")
+	}
+
+	for i := 0; i < df.GetRecordCount(); i++ {
+		for j, s := range columns {
+			e, err := s.GetElement(i)
+			if err != nil {
+				return errors.Wrap(err, "failed to get element")
+			}
+			row[j], err = config.render(e)
+			if err != nil {
+				return errors.Wrap(err, "failed to render element")
+			}
+		}
+		if err := writer.Write(row); err != nil {
+			return errors.Wrap(err, "failed to write row")
+		}
+	}
+	writer.Flush()
+	return errors.Wrap(writer.Error(), "failed to flush csv")
+}
+
+func (c writeCSVConfig) render(e element.Element) (string, error) {
+	if e.IsNA() {
+		return c.naRep, nil
+	}
+	switch v := e.(type) {
+	case element.NumericElement:
+		if c.floatFormat != 0 {
+			return strconv.FormatFloat(v.Value, c.floatFormat, c.precision, 64), nil
+		}
+	case element.StringListElement:
+		return strings.Join(v, c.listSeparator), nil
+	}
+	return e.String()
+}
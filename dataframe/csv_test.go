@@ -0,0 +1,344 @@
+package dataframe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+)
+
+func TestReadCSV(t *testing.T) {
+	type args struct {
+		input   string
+		options []ReadCSVOption
+	}
+	tests := []struct {
+		name string
+		args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				input: "name,salary\nAlice,100\nBob,\n",
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "name",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "Alice",
+								IsNull: false,
+							},
+							element.StringElement{
+								Value:  "Bob",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "salary",
+						Elements: element.NumericArray{
+							Values:   []float64{100, 0},
+							Validity: element.Bitmap{^uint64(1 << 1)},
+						},
+						AggregatedMethod: series.None,
+					},
+				},
+				RecordCount: 2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (na tokens, delimiter and quoting)",
+			args: args{
+				input: "name;salary\n\"Alice; Jr.\";NA\n-;200\n",
+				options: []ReadCSVOption{
+					WithDelimiter(';'),
+					WithNATokens("NA", "-"),
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "name",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "Alice; Jr.",
+								IsNull: false,
+							},
+							element.StringElement{
+								IsNull: true,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "salary",
+						Elements: element.NumericArray{
+							Values:   []float64{0, 200},
+							Validity: element.Bitmap{^uint64(1 << 0)},
+						},
+						AggregatedMethod: series.None,
+					},
+				},
+				RecordCount: 2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (skip rows and header override)",
+			args: args{
+				input: "exported at 2022-11-01\nA,B\n1,x\n",
+				options: []ReadCSVOption{
+					WithSkipRows(1),
+					WithHeader("id", "grade"),
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "id",
+						Elements: element.NumericArray{
+							Values: []float64{1},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "grade",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "x",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (without header row)",
+			args: args{
+				input: "1,x\n",
+				options: []ReadCSVOption{
+					WithoutHeaderRow(),
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "0",
+						Elements: element.NumericArray{
+							Values: []float64{1},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "1",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "x",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (wrong number of fields)",
+			args: args{
+				input: "a,b\n1,2,3\n",
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+		{
+			name: "fail (header length mismatch)",
+			args: args{
+				input: "a,b\n1,2\n",
+				options: []ReadCSVOption{
+					WithHeader("a"),
+				},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+		{
+			name: "pass (non-finite numbers are NA)",
+			args: args{
+				input: "salary\n100\nNaN\nInf\n-infinity\n",
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "salary",
+						Elements: element.NumericArray{
+							Values:   []float64{100, 0, 0, 0},
+							Validity: element.Bitmap{^uint64(1<<1 | 1<<2 | 1<<3)},
+						},
+						AggregatedMethod: series.None,
+					},
+				},
+				RecordCount: 4,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (duplicate column name)",
+			args: args{
+				input: "a,a\n1,2\n",
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tt.args.input), tt.args.options...)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestReadCSV_ToJSON(t *testing.T) {
+	df, err := ReadCSV(strings.NewReader("name,salary\nAlice,NaN\nBob,Inf\nCarol,100\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := df.ToJSON(RecordsOrient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"name":"Alice","salary":null},{"name":"Bob","salary":null},{"name":"Carol","salary":100}]`
+	if diff := cmp.Diff(string(got), want); diff != "" {
+		t.Error(diff)
+	}
+	data, err := df.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unmarshaled DataFrame
+	if err := unmarshaled.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(unmarshaled, df); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestDataFrame_WriteCSV(t *testing.T) {
+	type fields struct {
+		DataFrame
+	}
+	type args struct {
+		options []WriteCSVOption
+	}
+	df := NewDataFrame(Columns{
+		{
+			Name: "name",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "Alice, Jr.",
+					IsNull: false,
+				},
+				element.StringElement{
+					IsNull: true,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "salary",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  100.5,
+					IsNull: false,
+				},
+				element.NumericElement{
+					IsNull: true,
+				},
+			},
+			AggregatedMethod: series.Mean,
+		},
+		{
+			Name: "tags",
+			Elements: element.StringListElements{
+				element.StringListElement{"a", "b"},
+				element.StringListElement{},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	tests := []struct {
+		name string
+		fields
+		args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				df,
+			},
+			args: args{},
+			want: "name,salary_Mean,tags\n" +
+				"\"Alice, Jr.\",100.500000,\"a,b\"\n" +
+				",,\n",
+			wantErr: false,
+		},
+		{
+			name: "pass (options)",
+			fields: fields{
+				df,
+			},
+			args: args{
+				options: []WriteCSVOption{
+					WithOutputDelimiter('\t'),
+					WithNARep("NA"),
+					WithFloatFormat('f', 1),
+					WithListSeparator("|"),
+					WithHeaderName(func(s series.Series) string {
+						return s.GetName().String()
+					}),
+				},
+			},
+			want: "name\tsalary\ttags\n" +
+				"Alice, Jr.\t100.5\ta|b\n" +
+				"NA\tNA\tNA\n",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tt.fields.WriteCSV(&buf, tt.args.options...)
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package dataframe
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hrbrain/goban/element"
 	"github.com/hrbrain/goban/series"
 	"github.com/pkg/errors"
 )
@@ -87,6 +92,47 @@ func (df DataFrame) Append(df2 DataFrame) (DataFrame, error) {
 	return NewDataFrame(newColumns), nil
 }
 
+// concat concatenates the dataframes at once in the order of the columns of the first dataframe.
+// Unlike appending one by one, each column is copied only once, so it takes linear time.
+func concat(dfs []DataFrame) (DataFrame, error) {
+	if len(dfs) == 0 {
+		return DataFrame{}, nil
+	}
+	var newColumns Columns
+	for _, col1 := range dfs[0].GetColumns() {
+		// the elements are copied into arrays which are not shared, so they are appended in place
+		elements, err := element.ToArray(col1.Elements)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to copy column")
+		}
+		for _, df := range dfs[1:] {
+			if df.GetColumns().Len() != dfs[0].GetColumns().Len() {
+				return DataFrame{}, errors.New("columns length mismatch")
+			}
+			col2, err := df.GetColumns().FindSeriesBy(col1.GetName(), col1.GetAggregatedMethod())
+			if err != nil {
+				return DataFrame{}, errors.Wrap(err, "failed to find column")
+			}
+			if col1.GetType() != col2.GetType() {
+				return DataFrame{}, errors.New("column type mismatch")
+			}
+			elements, err = elements.Append(col2.Elements)
+			if err != nil {
+				return DataFrame{}, errors.Wrap(err, "failed to append column")
+			}
+		}
+		newCol, err := col1.UpdateElements(elements)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "")
+		}
+		newColumns, err = newColumns.Append(newCol)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to append columns")
+		}
+	}
+	return NewDataFrame(newColumns), nil
+}
+
 // Records convert dataframe into a list of records
 func (df DataFrame) Records() (Records, error) {
 	records := NewRecords(nil)
@@ -99,8 +145,7 @@ func (df DataFrame) Records() (Records, error) {
 			if err != nil {
 				return nil, errors.Wrap(err, "")
 			}
-			record, err = record.AddField(s.GetName(), element)
-			if err != nil {
+			if err := record.addField(s.GetName(), element); err != nil {
 				return nil, errors.Wrap(err, "")
 			}
 		}
@@ -109,75 +154,167 @@ func (df DataFrame) Records() (Records, error) {
 	return records, nil
 }
 
-// LoadRecord load a record into dataframe
+// LoadRecord load a record into dataframe. The receiver is not modified.
+// It copies the columns of the record, so use LoadRecords to load many records.
 func (df DataFrame) LoadRecord(r Record) (DataFrame, error) {
-	newDataFrame := NewDataFrame(nil)
+	newDataFrame, err := df.LoadRecords(Records{r})
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	return newDataFrame, nil
+}
+
+// LoadRecords load records into dataframe. The receiver is not modified.
+func (df DataFrame) LoadRecords(records Records) (DataFrame, error) {
+	// columns are clipped once, so that they are reallocated on the first record
+	// and the rest of records are appended in place without copying elements for each record
+	newDataFrame, err := df.clip()
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	for _, record := range records {
+		if err := newDataFrame.loadRecord(record); err != nil {
+			return DataFrame{}, errors.Wrap(err, "")
+		}
+	}
+	return newDataFrame, nil
+}
+
+// loadRecord adds the elements of the record into the columns in place.
+// The columns must not be shared with other dataframes.
+func (df *DataFrame) loadRecord(r Record) error {
 	for _, seriesName := range r.GetSeriesNames() {
 		element, err := r.GetElement(seriesName)
 		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "failed to get element")
+			return errors.Wrap(err, "failed to get element")
 		}
-		s, err := df.GetColumnByNameAndMethod(seriesName, series.None)
-		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "failed to get column")
+		index := -1
+		for i, s := range df.Columns {
+			if s.GetName() == seriesName && s.GetAggregatedMethod() == series.None {
+				index = i
+				break
+			}
 		}
-		s, err = s.AddElement(element)
-		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "failed to add element")
+		if index < 0 {
+			return fmt.Errorf("failed to get column, name: %s", seriesName)
 		}
-		newDataFrame, err = df.UpdateColumn(s)
+		elements, err := df.Columns[index].Elements.AddElement(element)
 		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "failed to update column")
+			return errors.Wrap(err, "failed to add element")
 		}
+		df.Columns[index].Elements = elements
 	}
-	return newDataFrame, nil
+	df.RecordCount = df.Columns.RecordCount()
+	return nil
 }
 
-// LoadRecords load records into dataframe
-func (df DataFrame) LoadRecords(records Records) (DataFrame, error) {
-	var newDataframe DataFrame
-	for _, record := range records {
-		var err error
-		newDataframe, err = df.LoadRecord(record)
-		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "")
+// GroupBy group by designated columns. Groups are keyed by the elements of the columns in the order of names,
+// and ordered by their first appearance by default, so the order changes with the order of rows.
+// Use GroupBySorted or Groups.Sort to order groups by their keys.
+// Rows are hashed once by their keys, so grouping scales linearly. Numeric and integer columns of groups are stored as arrays.
+func (df DataFrame) GroupBy(names ...series.Name) (Groups, error) {
+	if len(names) == 0 {
+		return Groups{}, errors.New("no column to group by")
+	}
+	keyColumns := make(Columns, 0, len(names))
+	for _, name := range names {
+		s, ok := df.GetColumns().HasSeriesName(name)
+		if !ok {
+			return Groups{}, fmt.Errorf("column not found, name: %s", name)
 		}
+		keyColumns = append(keyColumns, s)
 	}
-	return newDataframe, nil
-}
 
-// GroupBy group by a designated column
-func (df DataFrame) GroupBy(columnName series.Name) (Groups, error) {
-	// convert the dataframe into a list of records
-	records, err := df.Records()
-	if err != nil {
-		return Groups{}, errors.Wrap(err, "failed to convert to records")
+	// collect row indices of each key
+	groupIndexes := map[string]int{}
+	var keys []Key
+	var rowIndexes [][]int
+	var hash strings.Builder
+	for i := 0; i < df.GetRecordCount(); i++ {
+		key := make(Key, 0, len(names))
+		hash.Reset()
+		for _, s := range keyColumns {
+			elem, err := s.GetElement(i)
+			if err != nil {
+				return Groups{}, errors.Wrap(err, "failed to get element")
+			}
+			key = append(key, KeyElement{SeriesName: s.GetName(), Element: elem})
+			// length prefix keeps composite keys unambiguous
+			elemKey := element.Key(elem)
+			hash.WriteString(strconv.Itoa(len(elemKey)))
+			hash.WriteByte(':')
+			hash.WriteString(elemKey)
+		}
+		groupIndex, ok := groupIndexes[hash.String()]
+		if !ok {
+			groupIndex = len(keys)
+			groupIndexes[hash.String()] = groupIndex
+			keys = append(keys, key)
+			rowIndexes = append(rowIndexes, nil)
+		}
+		rowIndexes[groupIndex] = append(rowIndexes[groupIndex], i)
 	}
 
-	// convert the list of records into a list of groups
-	groups := NewGroups(nil)
-	for _, r := range records {
-		elem, err := r.GetElement(columnName)
+	// materialise groups from the columns, which are taken without boxing values once stored as arrays
+	arrays := df.toArrays()
+	groups := make(Groups, 0, len(keys))
+	for i, key := range keys {
+		dataFrame, err := arrays.Take(rowIndexes[i])
 		if err != nil {
-			return Groups{}, errors.Wrap(err, "failed to get element")
+			return Groups{}, errors.Wrap(err, "failed to take rows")
 		}
-		dataFrame := groups.FindGroup(columnName, elem)
-		if dataFrame.IsEmpty() {
-			// if group does not exist, put the same structure dataframe as the original dataframe
-			dataFrame, err = df.Delete()
-			if err != nil {
-				return Groups{}, errors.Wrap(err, "failed to delete dataframe")
-			}
+		group := NewGroupWithKey(key, dataFrame)
+		group.Indices = rowIndexes[i]
+		group.SourceRecordCount = df.GetRecordCount()
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// toArrays returns the dataframe whose numeric and integer columns are stored as element.NumericArray and element.IntArray
+func (df DataFrame) toArrays() DataFrame {
+	columns := df.Columns.copy()
+	for i, s := range columns {
+		switch e := s.Elements.(type) {
+		case element.NumericElements:
+			columns[i].Elements = e.ToNumericArray()
+		case element.IntElements:
+			columns[i].Elements = e.ToIntArray()
 		}
+	}
+	df.Columns = columns
+	return df
+}
 
-		// add the record to the existing group
-		dataFrame, err = dataFrame.LoadRecord(r)
+// Clone returns a copy of the dataframe which shares no columns and elements with the receiver
+func (df DataFrame) Clone() (DataFrame, error) {
+	columns, err := df.Columns.Clone()
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	df.Columns = columns
+	return df, nil
+}
+
+// clip returns the dataframe with columns of its own whose elements have no spare capacity,
+// so that adding elements to its columns does not affect the receiver
+func (df DataFrame) clip() (DataFrame, error) {
+	if df.Columns == nil {
+		return df, nil
+	}
+	columns := df.Columns.copy()
+	for i, s := range columns {
+		if s.Elements == nil {
+			continue
+		}
+		elements, err := element.Clip(s.Elements)
 		if err != nil {
-			return Groups{}, errors.Wrap(err, "failed to load record")
+			return DataFrame{}, errors.Wrapf(err, "failed to clip series, name: %s", s.GetName())
 		}
-		groups = groups.Update(columnName, elem, dataFrame)
+		columns[i].Elements = elements
 	}
-	return groups, nil
+	df.Columns = columns
+	return df, nil
 }
 
 // Delete delete data keeping its schema
@@ -190,6 +327,9 @@ func (df DataFrame) Delete() (DataFrame, error) {
 }
 
 func (df DataFrame) Aggregate(conditions AggregationConditions) (DataFrame, error) {
+	if err := conditions.Validate(); err != nil {
+		return DataFrame{}, errors.Wrap(err, "invalid aggregation conditions")
+	}
 	newColumns, err := NewColumns(nil)
 	if err != nil {
 		return DataFrame{}, errors.Wrap(err, "")
@@ -201,12 +341,12 @@ func (df DataFrame) Aggregate(conditions AggregationConditions) (DataFrame, erro
 			return DataFrame{}, errors.Wrap(err, "failed to find column")
 		}
 		aggregationMethod := condition.GetMethod()
-		aggregatedValue, err := col.Aggregate(aggregationMethod)
+		aggregatedValue, err := col.Aggregate(aggregationMethod, condition.GetAggregateOptions()...)
 		if err != nil {
 			return DataFrame{}, errors.Wrap(err, "failed to aggregate series")
 		}
 
-		newSeries, err := series.NewSeries(col.GetName(), aggregatedValue.ToElements(), aggregationMethod)
+		newSeries, err := series.NewSeries(condition.OutputName(), aggregatedValue.ToElements(), condition.OutputMethod())
 		if err != nil {
 			return DataFrame{}, errors.Wrap(err, "failed to make new series")
 		}
@@ -246,6 +386,37 @@ func (df DataFrame) DropNA(names ...series.Name) (DataFrame, error) {
 	return newDataframe, nil
 }
 
+// Filter keeps rows where the mask is true. NA in the mask is treated as false.
+func (df DataFrame) Filter(mask series.Series) (DataFrame, error) {
+	boolElements, ok := mask.Elements.(element.BoolElements)
+	if !ok {
+		return DataFrame{}, fmt.Errorf("mask is not bool elements, type: %s", mask.GetType())
+	}
+	if boolElements.Len() != df.GetRecordCount() {
+		return DataFrame{}, fmt.Errorf("mask length mismatch, mask.Len(): %d, recordCount: %d", boolElements.Len(), df.GetRecordCount())
+	}
+	var indexes []int
+	for i, b := range boolElements.Mask() {
+		if b {
+			indexes = append(indexes, i)
+		}
+	}
+	return df.Take(indexes)
+}
+
+// Take make a dataframe with rows at the indexes
+func (df DataFrame) Take(indexes []int) (DataFrame, error) {
+	newColumns := make(Columns, df.GetColumns().Len())
+	for i, s := range df.GetColumns() {
+		var err error
+		newColumns[i], err = s.Take(indexes)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to take series")
+		}
+	}
+	return NewDataFrame(newColumns), nil
+}
+
 func (df DataFrame) IsEmpty() bool {
 	return df.GetColumns().IsEmpty()
 }
@@ -1,6 +1,7 @@
 package dataframe
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -412,8 +413,11 @@ func TestDataFrame_GroupBy(t *testing.T) {
 			},
 			want: []Group{
 				{
-					SeriesName: "series_1",
-					Element:    element.StringElement{Value: "Apple"},
+					SeriesName:        "series_1",
+					Element:           element.StringElement{Value: "Apple"},
+					Key:               Key{{SeriesName: "series_1", Element: element.StringElement{Value: "Apple"}}},
+					Indices:           []int{0, 3},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -434,8 +438,11 @@ func TestDataFrame_GroupBy(t *testing.T) {
 					},
 				},
 				{
-					SeriesName: "series_1",
-					Element:    element.StringElement{Value: "Orange"},
+					SeriesName:        "series_1",
+					Element:           element.StringElement{Value: "Orange"},
+					Key:               Key{{SeriesName: "series_1", Element: element.StringElement{Value: "Orange"}}},
+					Indices:           []int{1},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -452,8 +459,11 @@ func TestDataFrame_GroupBy(t *testing.T) {
 					},
 				},
 				{
-					SeriesName: "series_1",
-					Element:    element.StringElement{IsNull: true},
+					SeriesName:        "series_1",
+					Element:           element.StringElement{IsNull: true},
+					Key:               Key{{SeriesName: "series_1", Element: element.StringElement{IsNull: true}}},
+					Indices:           []int{2, 4},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -507,8 +517,11 @@ func TestDataFrame_GroupBy(t *testing.T) {
 			},
 			want: []Group{
 				{
-					SeriesName: "series_1",
-					Element:    element.NumericElement{Value: 1},
+					SeriesName:        "series_1",
+					Element:           element.NumericElement{Value: 1},
+					Key:               Key{{SeriesName: "series_1", Element: element.NumericElement{Value: 1}}},
+					Indices:           []int{0, 3},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -522,15 +535,18 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  1,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 						},
 						RecordCount: 2,
 					},
 				},
 				{
-					SeriesName: "series_1",
-					Element:    element.NumericElement{Value: 2},
+					SeriesName:        "series_1",
+					Element:           element.NumericElement{Value: 2},
+					Key:               Key{{SeriesName: "series_1", Element: element.NumericElement{Value: 2}}},
+					Indices:           []int{1},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -540,15 +556,18 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  2,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 						},
 						RecordCount: 1,
 					},
 				},
 				{
-					SeriesName: "series_1",
-					Element:    element.NumericElement{IsNull: true},
+					SeriesName:        "series_1",
+					Element:           element.NumericElement{IsNull: true},
+					Key:               Key{{SeriesName: "series_1", Element: element.NumericElement{IsNull: true}}},
+					Indices:           []int{2, 4},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -560,7 +579,7 @@ func TestDataFrame_GroupBy(t *testing.T) {
 									element.NumericElement{
 										IsNull: true,
 									},
-								},
+								}.ToNumericArray(),
 							},
 						},
 						RecordCount: 2,
@@ -656,8 +675,11 @@ func TestDataFrame_GroupBy(t *testing.T) {
 			},
 			want: []Group{
 				{
-					SeriesName: "series_2",
-					Element:    element.StringElement{Value: "aaa"},
+					SeriesName:        "series_2",
+					Element:           element.StringElement{Value: "aaa"},
+					Key:               Key{{SeriesName: "series_2", Element: element.StringElement{Value: "aaa"}}},
+					Indices:           []int{0, 3},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -671,7 +693,7 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  1,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 							{
 								Name: "series_2",
@@ -697,15 +719,18 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  77,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 						},
 						RecordCount: 2,
 					},
 				},
 				{
-					SeriesName: "series_2",
-					Element:    element.StringElement{Value: "bbb"},
+					SeriesName:        "series_2",
+					Element:           element.StringElement{Value: "bbb"},
+					Key:               Key{{SeriesName: "series_2", Element: element.StringElement{Value: "bbb"}}},
+					Indices:           []int{1},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -715,7 +740,7 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  2,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 							{
 								Name: "series_2",
@@ -733,15 +758,18 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  88,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 						},
 						RecordCount: 1,
 					},
 				},
 				{
-					SeriesName: "series_2",
-					Element:    element.StringElement{IsNull: true},
+					SeriesName:        "series_2",
+					Element:           element.StringElement{IsNull: true},
+					Key:               Key{{SeriesName: "series_2", Element: element.StringElement{IsNull: true}}},
+					Indices:           []int{2, 4},
+					SourceRecordCount: 5,
 					DataFrame: DataFrame{
 						Columns: []series.Series{
 							{
@@ -755,7 +783,7 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  3,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 							{
 								Name: "series_2",
@@ -779,7 +807,7 @@ func TestDataFrame_GroupBy(t *testing.T) {
 										Value:  55,
 										IsNull: false,
 									},
-								},
+								}.ToNumericArray(),
 							},
 						},
 						RecordCount: 2,
@@ -804,6 +832,202 @@ func TestDataFrame_GroupBy(t *testing.T) {
 	}
 }
 
+func TestDataFrame_GroupBy_MultipleColumns(t *testing.T) {
+	df := NewDataFrame(Columns{
+		{
+			Name: "department",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "grade",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "A",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "B",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "A",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "salary",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  100,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  200,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  300,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	type args struct {
+		names      []series.Name
+		conditions AggregationConditions
+	}
+	tests := []struct {
+		name string
+		args
+		wantKeys []Key
+		want     DataFrame
+		wantErr  bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				names: []series.Name{"department", "grade"},
+				conditions: AggregationConditions{
+					NewAggregationCondition("department", series.None),
+					NewAggregationCondition("grade", series.None),
+					NewAggregationCondition("salary", series.Mean),
+				},
+			},
+			wantKeys: []Key{
+				{
+					{
+						SeriesName: "department",
+						Element:    element.NewStringElement("sales", false),
+					},
+					{
+						SeriesName: "grade",
+						Element:    element.NewStringElement("A", false),
+					},
+				},
+				{
+					{
+						SeriesName: "department",
+						Element:    element.NewStringElement("sales", false),
+					},
+					{
+						SeriesName: "grade",
+						Element:    element.NewStringElement("B", false),
+					},
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "department",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "sales",
+								IsNull: false,
+							},
+							element.StringElement{
+								Value:  "sales",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "grade",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "A",
+								IsNull: false,
+							},
+							element.StringElement{
+								Value:  "B",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "salary",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  200,
+								IsNull: false,
+							},
+							element.NumericElement{
+								Value:  200,
+								IsNull: false,
+							},
+						}.ToNumericArray(),
+						AggregatedMethod: series.Mean,
+					},
+				},
+				RecordCount: 2,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (column not found)",
+			args: args{
+				names: []series.Name{"department", "location"},
+			},
+			wantKeys: nil,
+			want:     DataFrame{},
+			wantErr:  true,
+		},
+		{
+			name: "fail (no column)",
+			args: args{
+				names: nil,
+			},
+			wantKeys: nil,
+			want:     DataFrame{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := df.GroupBy(tt.args.names...)
+			var gotKeys []Key
+			for _, g := range groups {
+				gotKeys = append(gotKeys, g.GetKey())
+			}
+			if diff := cmp.Diff(gotKeys, tt.wantKeys); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+			if err != nil {
+				return
+			}
+			got, err := groups.Aggregate(tt.args.conditions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestDataFrame_Records(t *testing.T) {
 	type fields struct {
 		DataFrame
@@ -1641,6 +1865,86 @@ func TestDataFrame_Append(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "pass (numeric array and numeric elements)",
+			fields: fields{
+				NewDataFrame(Columns{
+					{
+						Name: "salary",
+						Elements: element.NumericArray{
+							Values:   []float64{0, 200},
+							Validity: element.Bitmap{^uint64(1 << 0)},
+						},
+						AggregatedMethod: series.None,
+					},
+				}),
+			},
+			args: args{
+				NewDataFrame(Columns{
+					{
+						Name: "salary",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  300,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+				}),
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "salary",
+					Elements: element.NumericArray{
+						Values:   []float64{0, 200, 300},
+						Validity: element.Bitmap{^uint64(1 << 0)},
+					},
+					AggregatedMethod: series.None,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "pass (numeric elements and numeric array)",
+			fields: fields{
+				NewDataFrame(Columns{
+					{
+						Name: "salary",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  300,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+				}),
+			},
+			args: args{
+				NewDataFrame(Columns{
+					{
+						Name: "salary",
+						Elements: element.NumericArray{
+							Values:   []float64{0, 200},
+							Validity: element.Bitmap{^uint64(1 << 0)},
+						},
+						AggregatedMethod: series.None,
+					},
+				}),
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "salary",
+					Elements: element.NumericArray{
+						Values:   []float64{300, 0, 200},
+						Validity: element.Bitmap{^uint64(1 << 1)},
+					},
+					AggregatedMethod: series.None,
+				},
+			}),
+			wantErr: false,
+		},
 		{
 			name: "fail (columns length mismatch)",
 			fields: fields{
@@ -2210,106 +2514,316 @@ func TestDataFrame_Aggregate(t *testing.T) {
 			want:    DataFrame{},
 			wantErr: true,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.fields.DataFrame.Aggregate(tt.args.AggregationConditions)
-			if diff := cmp.Diff(got, tt.want); diff != "" {
-				t.Errorf(diff)
-			}
-			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
-				t.Error(diff)
-				t.Log(err)
-			}
-		})
-	}
-}
-
-func TestDataFrame_DropNA(t *testing.T) {
-	type args struct {
-		names []series.Name
-	}
-	type fields struct {
-		DataFrame
-	}
-	tests := []struct {
-		name string
-		fields
-		args    args
-		want    DataFrame
-		wantErr bool
-	}{
 		{
-			name: "drop all series",
+			name: "pass (NA policies)",
 			fields: fields{
 				DataFrame{
 					Columns: []series.Series{
 						{
-							Name: "series_1",
-							Elements: element.StringElements{
-								element.StringElement{
-									Value:  "Apple",
-									IsNull: false,
-								},
-								element.StringElement{
-									Value:  "",
-									IsNull: true,
-								},
-								element.StringElement{
-									Value:  "Orange",
-									IsNull: false,
-								},
-							},
-						},
-						{
-							Name: "series_2",
+							Name: "score",
 							Elements: element.NumericElements{
 								element.NumericElement{
-									Value:  20,
-									IsNull: false,
-								},
-								element.NumericElement{
-									Value:  30,
+									Value:  4,
 									IsNull: false,
 								},
 								element.NumericElement{
-									Value:  0,
 									IsNull: true,
 								},
 							},
+							AggregatedMethod: series.None,
 						},
 					},
-					RecordCount: 3,
+					RecordCount: 2,
+				},
+			},
+			args: args{
+				AggregationConditions{
+					{
+						ColumnName: "score",
+						Method:     series.Mean,
+					},
+					NewAggregationCondition("score", series.Sum).WithNAPolicy(series.PropagateNA),
+					{
+						ColumnName: "score",
+						Method:     series.Count,
+					},
+					{
+						ColumnName: "score",
+						Method:     series.Size,
+					},
 				},
 			},
 			want: DataFrame{
 				Columns: []series.Series{
 					{
-						Name: "series_1",
-						Elements: element.StringElements{
-							element.StringElement{
-								Value:  "Apple",
+						Name: "score",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  4,
 								IsNull: false,
 							},
 						},
+						AggregatedMethod: series.Mean,
 					},
 					{
-						Name: "series_2",
+						Name: "score",
 						Elements: element.NumericElements{
 							element.NumericElement{
-								Value:  20,
+								IsNull: true,
+							},
+						},
+						AggregatedMethod: series.Sum,
+					},
+					{
+						Name: "score",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  1,
 								IsNull: false,
 							},
 						},
+						AggregatedMethod: series.Count,
 					},
-				},
-				RecordCount: 1,
-			},
-			wantErr: false,
-		},
-		{
-			name: "drop specific all series",
+					{
+						Name: "score",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  2,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.Size,
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (aliases)",
+			fields: fields{
+				DataFrame{
+					Columns: []series.Series{
+						{
+							Name: "salary",
+							Elements: element.NumericElements{
+								element.NumericElement{
+									Value:  100,
+									IsNull: false,
+								},
+								element.NumericElement{
+									Value:  300,
+									IsNull: false,
+								},
+							},
+							AggregatedMethod: series.None,
+						},
+					},
+					RecordCount: 2,
+				},
+			},
+			args: args{
+				AggregationConditions{
+					NewAggregationCondition("salary", series.Mean).WithAlias("salary_mean"),
+					NewAggregationCondition("salary", series.Max).WithAlias("salary_max"),
+					NewAggregationCondition("salary", series.Size).WithAlias("headcount"),
+				},
+			},
+			want: DataFrame{
+				Columns: []series.Series{
+					{
+						Name: "salary_mean",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  200,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "salary_max",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  300,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "headcount",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  2,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (rounding mode of decimal mean)",
+			fields: fields{
+				NewDataFrame(Columns{
+					{
+						Name: "salary",
+						Elements: element.DecimalElements{
+							element.NewDecimalElement(1, 2, false),
+							element.NewDecimalElement(4, 2, false),
+						},
+						AggregatedMethod: series.None,
+					},
+				}),
+			},
+			args: args{
+				AggregationConditions{
+					NewAggregationCondition("salary", series.Mean).WithOptions(series.WithMeanRoundingMode(element.RoundHalfEven)),
+				},
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "salary",
+					Elements: element.DecimalElements{
+						element.NewDecimalElement(2, 2, false),
+					},
+					AggregatedMethod: series.Mean,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "fail (duplicate alias)",
+			fields: fields{
+				DataFrame{
+					Columns: []series.Series{
+						{
+							Name: "salary",
+							Elements: element.NumericElements{
+								element.NumericElement{
+									Value:  100,
+									IsNull: false,
+								},
+							},
+							AggregatedMethod: series.None,
+						},
+					},
+					RecordCount: 1,
+				},
+			},
+			args: args{
+				AggregationConditions{
+					NewAggregationCondition("salary", series.Mean).WithAlias("salary"),
+					NewAggregationCondition("salary", series.Max).WithAlias("salary"),
+				},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.DataFrame.Aggregate(tt.args.AggregationConditions)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDataFrame_DropNA(t *testing.T) {
+	type args struct {
+		names []series.Name
+	}
+	type fields struct {
+		DataFrame
+	}
+	tests := []struct {
+		name string
+		fields
+		args    args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "drop all series",
+			fields: fields{
+				DataFrame{
+					Columns: []series.Series{
+						{
+							Name: "series_1",
+							Elements: element.StringElements{
+								element.StringElement{
+									Value:  "Apple",
+									IsNull: false,
+								},
+								element.StringElement{
+									Value:  "",
+									IsNull: true,
+								},
+								element.StringElement{
+									Value:  "Orange",
+									IsNull: false,
+								},
+							},
+						},
+						{
+							Name: "series_2",
+							Elements: element.NumericElements{
+								element.NumericElement{
+									Value:  20,
+									IsNull: false,
+								},
+								element.NumericElement{
+									Value:  30,
+									IsNull: false,
+								},
+								element.NumericElement{
+									Value:  0,
+									IsNull: true,
+								},
+							},
+						},
+					},
+					RecordCount: 3,
+				},
+			},
+			want: DataFrame{
+				Columns: []series.Series{
+					{
+						Name: "series_1",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "Apple",
+								IsNull: false,
+							},
+						},
+					},
+					{
+						Name: "series_2",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  20,
+								IsNull: false,
+							},
+						},
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "drop specific all series",
 			fields: fields{
 				DataFrame{
 					Columns: []series.Series{
@@ -2478,3 +2992,686 @@ func TestDataFrame_DropNA(t *testing.T) {
 		})
 	}
 }
+
+func TestDataFrame_Filter(t *testing.T) {
+	type fields struct {
+		DataFrame
+	}
+	type args struct {
+		mask series.Series
+	}
+	df := NewDataFrame(Columns{
+		{
+			Name: "series_1",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "a",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "b",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "c",
+					IsNull: false,
+				},
+			},
+		},
+		{
+			Name: "series_2",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  1,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  2,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  3,
+					IsNull: false,
+				},
+			},
+		},
+	})
+	tests := []struct {
+		name string
+		fields
+		args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				df,
+			},
+			args: args{
+				mask: series.Series{
+					Name: "mask",
+					Elements: element.BoolElements{
+						element.BoolElement{
+							Value:  true,
+							IsNull: false,
+						},
+						element.BoolElement{
+							Value:  false,
+							IsNull: false,
+						},
+						element.BoolElement{
+							Value:  true,
+							IsNull: true,
+						},
+					},
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "series_1",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "a",
+								IsNull: false,
+							},
+						},
+					},
+					{
+						Name: "series_2",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  1,
+								IsNull: false,
+							},
+						},
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (not bool)",
+			fields: fields{
+				df,
+			},
+			args: args{
+				mask: series.Series{
+					Name: "mask",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  1,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  1,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  1,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+		{
+			name: "fail (length mismatch)",
+			fields: fields{
+				df,
+			},
+			args: args{
+				mask: series.Series{
+					Name: "mask",
+					Elements: element.BoolElements{
+						element.BoolElement{
+							Value:  true,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.DataFrame.Filter(tt.args.mask)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Errorf(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+// benchmarkDataFrame makes a dataframe of n rows grouped into 100 departments
+func benchmarkDataFrame(n int) DataFrame {
+	departments := make(element.StringElements, n)
+	salaries := make(element.NumericElements, n)
+	for i := 0; i < n; i++ {
+		departments[i] = element.NewStringElement(fmt.Sprintf("department_%d", i%100), false)
+		salaries[i] = element.NewNumericElement(float64(i), false)
+	}
+	return NewDataFrame(Columns{
+		{
+			Name:             "department",
+			Elements:         departments,
+			AggregatedMethod: series.None,
+		},
+		{
+			Name:             "salary",
+			Elements:         salaries,
+			AggregatedMethod: series.None,
+		},
+	})
+}
+
+func BenchmarkDataFrame_GroupBy(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		df := benchmarkDataFrame(n)
+		b.Run(fmt.Sprintf("rows=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := df.GroupBy("department"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDataFrame_LoadRecords(b *testing.B) {
+	for _, n := range []int{10000, 20000, 40000} {
+		records, err := benchmarkDataFrame(n).Records()
+		if err != nil {
+			b.Fatal(err)
+		}
+		template, err := benchmarkDataFrame(0).Delete()
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("records=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := template.LoadRecords(records); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// immutabilityNumericElements has spare capacity, so that appending into it in place would be visible
+func immutabilityNumericElements() element.Elements {
+	salaries := make(element.NumericElements, 0, 8)
+	return append(salaries,
+		element.NewNumericElement(100, false),
+		element.NewNumericElement(0, true),
+		element.NewNumericElement(300, false),
+	)
+}
+
+// immutabilityNumericArray has spare capacity in its values and validity, so that appending into them in place would be visible
+func immutabilityNumericArray() element.Elements {
+	values := make([]float64, 0, 8)
+	validity := make(element.Bitmap, 0, 2)
+	return element.NumericArray{
+		Values:   append(values, 100, 0, 300),
+		Validity: append(validity, ^uint64(1<<1)),
+	}
+}
+
+// spareCapacity extends the salaries to their capacity
+func spareCapacity(salaries element.Elements) interface{} {
+	switch s := salaries.(type) {
+	case element.NumericElements:
+		return s[:cap(s)]
+	case element.NumericArray:
+		return element.NumericArray{Values: s.Values[:cap(s.Values)], Validity: s.Validity[:cap(s.Validity)]}
+	}
+	return nil
+}
+
+// immutabilityDataFrame has spare capacity in its columns and elements, so that appending into them in place would be visible
+func immutabilityDataFrame(salaries element.Elements) DataFrame {
+	departments := make(element.StringElements, 0, 8)
+	departments = append(departments,
+		element.NewStringElement("sales", false),
+		element.NewStringElement("dev", false),
+		element.NewStringElement("sales", false),
+	)
+	columns := make(Columns, 0, 4)
+	columns = append(columns,
+		series.Series{
+			Name:             "department",
+			Elements:         departments,
+			AggregatedMethod: series.None,
+		},
+		series.Series{
+			Name:             "salary",
+			Elements:         salaries,
+			AggregatedMethod: series.None,
+		},
+	)
+	return NewDataFrame(columns)
+}
+
+func immutabilityRecord(department string, salary float64) Record {
+	return Record{
+		"department": element.NewStringElement(department, false),
+		"salary":     element.NewNumericElement(salary, false),
+	}
+}
+
+// mutateSeries changes the series by the methods which return a changed series
+func mutateSeries(s series.Series) error {
+	if _, err := s.AddElement(s.NAElement()); err != nil {
+		return err
+	}
+	_, err := s.Append(s)
+	return err
+}
+
+// mutateDataFrame changes the dataframe by the methods which return a changed dataframe
+func mutateDataFrame(df DataFrame) error {
+	for _, s := range df.GetColumns() {
+		added, err := s.AddElement(s.NAElement())
+		if err != nil {
+			return err
+		}
+		if _, err := df.GetColumns().ReplaceByName(added); err != nil {
+			return err
+		}
+		if err := mutateSeries(s); err != nil {
+			return err
+		}
+	}
+	_, err := df.Append(df)
+	return err
+}
+
+// mutateGroups changes the groups and their dataframes by the methods which return changed values
+func mutateGroups(groups Groups) error {
+	for _, group := range groups {
+		if err := mutateDataFrame(group.DataFrame); err != nil {
+			return err
+		}
+		if _, err := group.DataFrame.LoadRecord(immutabilityRecord("hr", 400)); err != nil {
+			return err
+		}
+		groups.UpdateByKey(group.GetKey(), NewDataFrame(nil))
+	}
+	groups.Append(groups[0])
+	return nil
+}
+
+// assertGroupsUnchanged compares the groups with the groups of the department of immutabilityDataFrame
+func assertGroupsUnchanged(groups Groups, df DataFrame) error {
+	want, err := df.GroupBy("department")
+	if err != nil {
+		return err
+	}
+	if diff := cmp.Diff(groups, want); diff != "" {
+		return fmt.Errorf("groups are changed: %s", diff)
+	}
+	return nil
+}
+
+func TestDataFrame_Immutability(t *testing.T) {
+	conditions := AggregationConditions{
+		NewAggregationCondition("salary", series.Sum),
+	}
+	tests := []struct {
+		name      string
+		transform func(df DataFrame) error
+	}{
+		{
+			name: "UpdateColumn",
+			transform: func(df DataFrame) error {
+				_, err := df.UpdateColumn(series.Series{
+					Name:             "salary",
+					Elements:         element.NumericElements{{Value: 1}, {Value: 2}, {Value: 3}},
+					AggregatedMethod: series.None,
+				})
+				return err
+			},
+		},
+		{
+			name: "LoadRecord",
+			transform: func(df DataFrame) error {
+				_, err := df.LoadRecord(immutabilityRecord("hr", 400))
+				return err
+			},
+		},
+		{
+			name: "LoadRecords",
+			transform: func(df DataFrame) error {
+				_, err := df.LoadRecords(Records{immutabilityRecord("hr", 400), immutabilityRecord("dev", 500)})
+				return err
+			},
+		},
+		{
+			name: "Append",
+			transform: func(df DataFrame) error {
+				_, err := df.Append(df)
+				return err
+			},
+		},
+		{
+			name: "Records",
+			transform: func(df DataFrame) error {
+				_, err := df.Records()
+				return err
+			},
+		},
+		{
+			name: "Delete",
+			transform: func(df DataFrame) error {
+				_, err := df.Delete()
+				return err
+			},
+		},
+		{
+			name: "Aggregate",
+			transform: func(df DataFrame) error {
+				_, err := df.Aggregate(conditions)
+				return err
+			},
+		},
+		{
+			name: "DropNA",
+			transform: func(df DataFrame) error {
+				_, err := df.DropNA()
+				return err
+			},
+		},
+		{
+			name: "Filter",
+			transform: func(df DataFrame) error {
+				_, err := df.Filter(series.Series{
+					Name:             "mask",
+					Elements:         element.BoolElements{{Value: true}, {Value: false}, {Value: true}},
+					AggregatedMethod: series.None,
+				})
+				return err
+			},
+		},
+		{
+			name: "Take",
+			transform: func(df DataFrame) error {
+				_, err := df.Take([]int{2, 0})
+				return err
+			},
+		},
+		{
+			name: "GroupBy",
+			transform: func(df DataFrame) error {
+				groups, err := df.GroupBy("department")
+				if err != nil {
+					return err
+				}
+				if _, err := groups.Aggregate(conditions); err != nil {
+					return err
+				}
+				_, err = groups.Apply(func(g Group) (DataFrame, error) {
+					return g.DataFrame.LoadRecord(immutabilityRecord("hr", 400))
+				})
+				return err
+			},
+		},
+		{
+			name: "Groups.Filter",
+			transform: func(df DataFrame) error {
+				groups, err := df.GroupBy("department")
+				if err != nil {
+					return err
+				}
+				filtered := groups.Filter(func(g Group) bool {
+					return true
+				})
+				if err := mutateGroups(filtered); err != nil {
+					return err
+				}
+				return assertGroupsUnchanged(groups, df)
+			},
+		},
+		{
+			name: "Groups.Sort",
+			transform: func(df DataFrame) error {
+				groups, err := df.GroupBy("department")
+				if err != nil {
+					return err
+				}
+				sorted, err := groups.Sort(Descending())
+				if err != nil {
+					return err
+				}
+				if err := mutateGroups(sorted); err != nil {
+					return err
+				}
+				return assertGroupsUnchanged(groups, df)
+			},
+		},
+		{
+			name: "Groups.Transform",
+			transform: func(df DataFrame) error {
+				groups, err := df.GroupBy("department")
+				if err != nil {
+					return err
+				}
+				transformed, err := groups.Transform("salary", series.Sum)
+				if err != nil {
+					return err
+				}
+				if err := mutateDataFrame(transformed); err != nil {
+					return err
+				}
+				return assertGroupsUnchanged(groups, df)
+			},
+		},
+		{
+			name: "Groups.Apply",
+			transform: func(df DataFrame) error {
+				groups, err := df.GroupBy("department")
+				if err != nil {
+					return err
+				}
+				// the dataframes of groups are returned as they are, so the result must not share them
+				applied, err := groups.Apply(func(g Group) (DataFrame, error) {
+					return g.DataFrame, nil
+				})
+				if err != nil {
+					return err
+				}
+				if err := mutateDataFrame(applied); err != nil {
+					return err
+				}
+				return assertGroupsUnchanged(groups, df)
+			},
+		},
+		{
+			name: "Columns.Replace",
+			transform: func(df DataFrame) error {
+				_, err := df.GetColumns().Replace(0, df.GetColumns()[1])
+				return err
+			},
+		},
+		{
+			name: "Columns.ReplaceByName",
+			transform: func(df DataFrame) error {
+				s, err := df.GetColumns()[1].AddElement(element.NewNumericElement(400, false))
+				if err != nil {
+					return err
+				}
+				_, err = df.GetColumns().ReplaceByName(s)
+				return err
+			},
+		},
+		{
+			name: "Columns.Append",
+			transform: func(df DataFrame) error {
+				_, err := df.GetColumns().Append(series.Series{
+					Name:             "grade",
+					Elements:         element.StringElements{{Value: "A"}, {Value: "B"}, {Value: "C"}},
+					AggregatedMethod: series.None,
+				})
+				return err
+			},
+		},
+		{
+			name: "Series.Append",
+			transform: func(df DataFrame) error {
+				_, err := df.GetColumns()[1].Append(df.GetColumns()[1])
+				return err
+			},
+		},
+		{
+			name: "Series.Cast",
+			transform: func(df DataFrame) error {
+				// casting into the same type returns the series as it is
+				for _, t := range []series.Type{series.NumericType, series.StringType} {
+					casted, _, err := df.GetColumns()[1].Cast(t)
+					if err != nil {
+						return err
+					}
+					if err := mutateSeries(casted); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			name: "Series.Split",
+			transform: func(df DataFrame) error {
+				split, err := df.GetColumns()[0].Split("a", -1)
+				if err != nil {
+					return err
+				}
+				return mutateSeries(split)
+			},
+		},
+		{
+			name: "Series.Slice and Series.Join",
+			transform: func(df DataFrame) error {
+				split, err := df.GetColumns()[0].Split("a", -1)
+				if err != nil {
+					return err
+				}
+				want, err := split.Clone()
+				if err != nil {
+					return err
+				}
+				sliced, err := split.Slice(0, 1)
+				if err != nil {
+					return err
+				}
+				if err := mutateSeries(sliced); err != nil {
+					return err
+				}
+				joined, err := split.Join("a")
+				if err != nil {
+					return err
+				}
+				if err := mutateSeries(joined); err != nil {
+					return err
+				}
+				if diff := cmp.Diff(split, want); diff != "" {
+					return fmt.Errorf("split series is changed: %s", diff)
+				}
+				return nil
+			},
+		},
+		{
+			name: "Series.AddElement",
+			transform: func(df DataFrame) error {
+				if _, err := df.GetColumns()[1].AddElement(element.NewNumericElement(0, true)); err != nil {
+					return err
+				}
+				_, err := df.GetColumns()[1].AddElement(element.NewNumericElement(400, false))
+				return err
+			},
+		},
+	}
+	storages := []struct {
+		name     string
+		salaries func() element.Elements
+	}{
+		{
+			name:     "NumericElements",
+			salaries: immutabilityNumericElements,
+		},
+		{
+			name:     "NumericArray",
+			salaries: immutabilityNumericArray,
+		},
+	}
+	for _, storage := range storages {
+		for _, tt := range tests {
+			t.Run(storage.name+"/"+tt.name, func(t *testing.T) {
+				df := immutabilityDataFrame(storage.salaries())
+				want, err := df.Clone()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := tt.transform(df); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(df, want); diff != "" {
+					t.Error(diff)
+				}
+				// the spare capacity must not be written either
+				if diff := cmp.Diff(df.GetColumns()[:cap(df.GetColumns())], immutabilityDataFrame(storage.salaries()).GetColumns()[:4]); diff != "" {
+					t.Error(diff)
+				}
+				if diff := cmp.Diff(spareCapacity(df.GetColumns()[1].Elements), spareCapacity(storage.salaries())); diff != "" {
+					t.Error(diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDataFrame_LoadRecord_SharedTemplate(t *testing.T) {
+	template, err := immutabilityDataFrame(immutabilityNumericElements()).Delete()
+	if err != nil {
+		t.Fatal(err)
+	}
+	df1, err := template.LoadRecord(immutabilityRecord("sales", 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	df2, err := template.LoadRecord(immutabilityRecord("dev", 200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want1 := NewDataFrame(Columns{
+		{
+			Name:             "department",
+			Elements:         element.StringElements{element.NewStringElement("sales", false)},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name:             "salary",
+			Elements:         element.NumericElements{element.NewNumericElement(100, false)},
+			AggregatedMethod: series.None,
+		},
+	})
+	if diff := cmp.Diff(df1, want1); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(df2.GetRecordCount(), 1); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(template.GetRecordCount(), 0); diff != "" {
+		t.Error(diff)
+	}
+}
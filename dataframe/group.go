@@ -6,27 +6,55 @@ import (
 )
 
 type Group struct {
+	// SeriesName and Element are the first part of Key. They are kept for compatibility.
 	SeriesName series.Name
 	Element    element.Element
-	DataFrame  DataFrame
+	// Key is the key of the group, which has one part for a single column
+	Key       Key
+	DataFrame DataFrame
+	// Indices are the row indices of the group in the dataframe made GroupBy
+	Indices []int
+	// SourceRecordCount is the record count of the dataframe made GroupBy
+	SourceRecordCount int
 }
 
 func NewGroup(name series.Name, element element.Element, dataframe DataFrame) Group {
+	return NewGroupWithKey(NewKey([]KeyElement{{SeriesName: name, Element: element}}), dataframe)
+}
+
+// NewGroupWithKey make a group of the composite key. The key must have at least one element.
+func NewGroupWithKey(key Key, dataframe DataFrame) Group {
 	return Group{
-		SeriesName: name,
-		Element:    element,
+		SeriesName: key[0].SeriesName,
+		Element:    key[0].Element,
+		Key:        key,
 		DataFrame:  dataframe,
 	}
 }
 
+// GetElement returns the element of the first part of the key
 func (g Group) GetElement() element.Element {
-	return g.Element
+	return g.GetKey()[0].Element
 }
 
+// GetSeriesName returns the series name of the first part of the key
 func (g Group) GetSeriesName() series.Name {
-	return g.SeriesName
+	return g.GetKey()[0].SeriesName
+}
+
+// GetKey returns the key. A group made as a literal without Key has the key of SeriesName and Element.
+func (g Group) GetKey() Key {
+	if g.Key == nil {
+		return NewKey([]KeyElement{{SeriesName: g.SeriesName, Element: g.Element}})
+	}
+	return g.Key
 }
 
 func (g Group) GetDataframe() DataFrame {
 	return g.DataFrame
 }
+
+// GetIndices returns the row indices of the group in the grouped dataframe
+func (g Group) GetIndices() []int {
+	return g.Indices
+}
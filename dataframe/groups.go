@@ -1,6 +1,10 @@
 package dataframe
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
 	"github.com/hrbrain/goban/element"
 	"github.com/hrbrain/goban/series"
 	"github.com/pkg/errors"
@@ -13,19 +17,33 @@ func NewGroups(groups []Group) Groups {
 }
 
 func (groups Groups) Update(name series.Name, element element.Element, dataFrame DataFrame) Groups {
+	return groups.UpdateByKey(NewKey([]KeyElement{{SeriesName: name, Element: element}}), dataFrame)
+}
+
+// UpdateByKey replace the dataframe of the group of the key, or append a new group if not found
+func (groups Groups) UpdateByKey(key Key, dataFrame DataFrame) Groups {
 	for i, g := range groups {
-		if g.GetSeriesName() == name && g.GetElement().Equal(element) {
-			groups[i].DataFrame = dataFrame
-			return groups
+		if g.GetKey().Equal(key) {
+			newGroups := make(Groups, groups.Len())
+			copy(newGroups, groups)
+			newGroups[i].DataFrame = dataFrame
+			// the rows of the new dataframe are not the rows of the grouped dataframe
+			newGroups[i].Indices = nil
+			return newGroups
 		}
 	}
-	newGroup := NewGroup(name, element, dataFrame)
+	newGroup := NewGroupWithKey(key, dataFrame)
 	return groups.Append(newGroup)
 }
 
 func (groups Groups) FindGroup(name series.Name, element element.Element) DataFrame {
+	return groups.FindGroupByKey(NewKey([]KeyElement{{SeriesName: name, Element: element}}))
+}
+
+// FindGroupByKey returns the dataframe of the group of the key, or an empty dataframe if not found
+func (groups Groups) FindGroupByKey(key Key) DataFrame {
 	for _, group := range groups {
-		if group.SeriesName == name && group.Element.Equal(element) {
+		if group.GetKey().Equal(key) {
 			return group.DataFrame
 		}
 	}
@@ -36,27 +54,333 @@ func (groups Groups) Len() int {
 	return len(groups)
 }
 
-func (groups Groups) Aggregate(conditions AggregationConditions) (DataFrame, error) {
-	var AggregatedDataframe DataFrame
+type groupsAggregateConfig struct {
+	withoutKeys bool
+	workers     int
+}
+
+// GroupsAggregateOption configures Groups.Aggregate
+type GroupsAggregateOption func(*groupsAggregateConfig)
+
+// WithoutKeys stops emitting the key columns of groups
+func WithoutKeys() GroupsAggregateOption {
+	return func(c *groupsAggregateConfig) {
+		c.withoutKeys = true
+	}
+}
+
+// WithWorkers aggregates groups concurrently by n goroutines. The default is 1, which aggregates sequentially.
+func WithWorkers(n int) GroupsAggregateOption {
+	return func(c *groupsAggregateConfig) {
+		c.workers = n
+	}
+}
+
+// Aggregate aggregates each group into a row.
+// The key columns of groups lead the row unless an unaliased condition with None method already outputs the key column.
+// An alias which is the name of a key column is rejected.
+func (groups Groups) Aggregate(conditions AggregationConditions, options ...GroupsAggregateOption) (DataFrame, error) {
+	return groups.AggregateContext(context.Background(), conditions, options...)
+}
+
+// AggregateContext is Aggregate which stops when ctx is done.
+// With WithWorkers, groups are aggregated concurrently and the rows are still in the order of groups.
+// It is safe because aggregation only reads the dataframes of groups and makes new series,
+// and every operation on DataFrame, Columns and Series returns a new value instead of modifying the groups.
+func (groups Groups) AggregateContext(ctx context.Context, conditions AggregationConditions, options ...GroupsAggregateOption) (DataFrame, error) {
+	config := groupsAggregateConfig{
+		workers: 1,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	if config.workers < 1 {
+		return DataFrame{}, fmt.Errorf("invalid worker count, workers: %d", config.workers)
+	}
+
+	aggregatedGroups, err := groups.aggregateGroups(ctx, conditions, config)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+
+	AggregatedDataframe, err := concat(aggregatedGroups)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to concatenate dataframes")
+	}
+	return AggregatedDataframe, nil
+}
+
+// aggregateGroups aggregates every group by the workers. The results are in the order of groups.
+func (groups Groups) aggregateGroups(ctx context.Context, conditions AggregationConditions, config groupsAggregateConfig) ([]DataFrame, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]DataFrame, groups.Len())
+	indexes := make(chan int)
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+	for w := 0; w < config.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result, err := groups[i].aggregate(conditions, config)
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[i] = result
+			}
+		}()
+	}
+
+	var ctxErr error
+feed:
+	for i := range groups {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			ctxErr = ctx.Err()
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if ctxErr != nil {
+		return nil, errors.Wrap(ctxErr, "aggregation is canceled")
+	}
+	return results, nil
+}
+
+// aggregate aggregates the dataframe of the group into a row
+func (g Group) aggregate(conditions AggregationConditions, config groupsAggregateConfig) (DataFrame, error) {
+	aggregated, err := g.GetDataframe().Aggregate(conditions)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to aggregate dataframe")
+	}
+	if config.withoutKeys {
+		return aggregated, nil
+	}
+	key := g.GetKey()
+	keyNames := make([]series.Name, 0, len(key))
+	for _, ke := range key {
+		keyNames = append(keyNames, ke.SeriesName)
+	}
+	if err := conditions.Validate(keyNames...); err != nil {
+		return DataFrame{}, errors.Wrap(err, "invalid aggregation conditions")
+	}
+	aggregated, err = g.prependKeyColumns(aggregated, 1, conditions.outputsKey)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to add key columns")
+	}
+	return aggregated, nil
+}
+
+// prependKeyColumns adds the key columns of rowCount rows in front of the dataframe except the columns which are covered
+func (g Group) prependKeyColumns(df DataFrame, rowCount int, covered func(series.Name) bool) (DataFrame, error) {
+	columns, err := NewColumns(nil)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	for _, ke := range g.GetKey() {
+		if covered(ke.SeriesName) {
+			continue
+		}
+		elements := ke.Element.ToElements().Delete()
+		for i := 0; i < rowCount; i++ {
+			elements, err = elements.AddElement(ke.Element)
+			if err != nil {
+				return DataFrame{}, errors.Wrap(err, "failed to add key element")
+			}
+		}
+		keySeries, err := series.NewSeries(ke.SeriesName, elements, series.None)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to make key series")
+		}
+		columns, err = columns.Append(keySeries)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to append key column")
+		}
+	}
+	for _, s := range df.GetColumns() {
+		columns, err = columns.Append(s)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to append column")
+		}
+	}
+	return NewDataFrame(columns), nil
+}
+
+// Append returns groups with the group appended. The receiver is not modified.
+func (groups Groups) Append(group Group) Groups {
+	newGroups := make(Groups, groups.Len(), groups.Len()+1)
+	copy(newGroups, groups)
+	return append(newGroups, group)
+}
+
+// Filter returns groups for which keep returns true. The groups are not modified.
+func (groups Groups) Filter(keep func(Group) bool) Groups {
+	filtered := NewGroups(nil)
+	for _, group := range groups {
+		if keep(group) {
+			filtered = append(filtered, group)
+		}
+	}
+	return filtered
+}
+
+// Transform aggregates the column in each group and repeats the result on every row of the group.
+// The result is a dataframe of the column aggregated by the method whose rows are aligned to the rows of the grouped dataframe.
+// Rows of groups dropped by Filter are NA. Rows of groups without indices, such as groups made by NewGroup or Update,
+// follow the rows of the grouped dataframe in the order of groups.
+func (groups Groups) Transform(name series.Name, method series.AggregationMethod) (DataFrame, error) {
+	if groups.Len() == 0 {
+		return DataFrame{}, nil
+	}
+	aggregated := make([]element.Element, groups.Len())
+	recordCount := 0
 	for i, group := range groups {
-		AggregatedDataframeInGroup, err := group.GetDataframe().Aggregate(conditions)
+		col, err := group.GetDataframe().GetColumnByNameAndMethod(name, series.None)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to find column")
+		}
+		aggregated[i], err = col.Aggregate(method)
 		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "failed to aggregate dataframe")
+			return DataFrame{}, errors.Wrap(err, "failed to aggregate series")
+		}
+		if group.SourceRecordCount > recordCount {
+			recordCount = group.SourceRecordCount
 		}
+	}
 
-		if i == 0 {
-			AggregatedDataframe = AggregatedDataframeInGroup
+	// nil is a row of no group
+	rows := make([]element.Element, recordCount)
+	for i, group := range groups {
+		for _, index := range group.GetIndices() {
+			if index < 0 || recordCount <= index {
+				return DataFrame{}, fmt.Errorf("row index out of range, index: %d, key: %v", index, group.GetKey())
+			}
+			if rows[index] != nil {
+				return DataFrame{}, fmt.Errorf("row belongs to multiple groups, index: %d", index)
+			}
+			rows[index] = aggregated[i]
+		}
+	}
+	for i, group := range groups {
+		if group.GetIndices() != nil {
 			continue
 		}
+		for j := 0; j < group.GetDataframe().GetRecordCount(); j++ {
+			rows = append(rows, aggregated[i])
+		}
+	}
+
+	na := series.Series{Elements: aggregated[0].ToElements()}.NAElement()
+	elements := aggregated[0].ToElements().Delete()
+	for _, e := range rows {
+		if e == nil {
+			e = na
+		}
+		var err error
+		elements, err = elements.AddElement(e)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to add element")
+		}
+	}
+	s, err := series.NewSeries(name, elements, method)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to make new series")
+	}
+	return NewDataFrame(Columns{s}), nil
+}
 
-		AggregatedDataframe, err = AggregatedDataframe.Append(AggregatedDataframeInGroup)
+// Apply runs fn on each group and concatenates the results.
+// The key columns of the group lead each result unless the result already has them without aggregated method.
+// Results are aligned to the union of their columns, and columns missing in a result are filled with NA.
+func (groups Groups) Apply(fn func(Group) (DataFrame, error)) (DataFrame, error) {
+	results := make([]DataFrame, 0, groups.Len())
+	schema, err := NewColumns(nil)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	for _, group := range groups {
+		result, err := fn(group)
+		if err != nil {
+			return DataFrame{}, errors.Wrapf(err, "failed to apply function, key: %v", group.GetKey())
+		}
+		result, err = group.prependKeyColumns(result, result.GetRecordCount(), func(name series.Name) bool {
+			_, err := result.GetColumns().FindSeriesBy(name, series.None)
+			return err == nil
+		})
 		if err != nil {
-			return DataFrame{}, errors.Wrap(err, "failed to append dataframe")
+			return DataFrame{}, errors.Wrap(err, "failed to add key columns")
+		}
+		for _, s := range result.GetColumns() {
+			existing, err := schema.FindSeriesBy(s.GetName(), s.GetAggregatedMethod())
+			if err != nil {
+				// empty columns are appended to keep the record count of the schema zero
+				schema, err = schema.Append(s.Delete())
+				if err != nil {
+					return DataFrame{}, errors.Wrap(err, "failed to append column to schema")
+				}
+				continue
+			}
+			if existing.GetType() != s.GetType() {
+				return DataFrame{}, fmt.Errorf("column type mismatch, name: %s, type: %s, type: %s", s.GetName(), existing.GetType(), s.GetType())
+			}
 		}
+		results = append(results, result)
 	}
-	return AggregatedDataframe, nil
+
+	aligned := []DataFrame{NewDataFrame(schema)}
+	for _, result := range results {
+		a, err := result.alignTo(schema)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to align result")
+		}
+		aligned = append(aligned, a)
+	}
+	applied, err := concat(aligned)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "failed to concatenate dataframes")
+	}
+	return applied, nil
 }
 
-func (groups Groups) Append(group Group) Groups {
-	return append(groups, group)
+// alignTo reorders the columns by the schema filling missing columns with NA
+func (df DataFrame) alignTo(schema Columns) (DataFrame, error) {
+	columns, err := NewColumns(nil)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	for _, schemaSeries := range schema {
+		s, err := df.GetColumns().FindSeriesBy(schemaSeries.GetName(), schemaSeries.GetAggregatedMethod())
+		if err != nil {
+			na := schemaSeries.NAElement()
+			elements := schemaSeries.Elements.Delete()
+			for i := 0; i < df.GetRecordCount(); i++ {
+				elements, err = elements.AddElement(na)
+				if err != nil {
+					return DataFrame{}, errors.Wrap(err, "failed to add NA")
+				}
+			}
+			s, err = schemaSeries.UpdateElements(elements)
+			if err != nil {
+				return DataFrame{}, errors.Wrap(err, "")
+			}
+		}
+		columns, err = columns.Append(s)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to append column")
+		}
+	}
+	return NewDataFrame(columns), nil
 }
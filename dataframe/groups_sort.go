@@ -0,0 +1,116 @@
+package dataframe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+	"github.com/pkg/errors"
+)
+
+type sortConfig struct {
+	descending bool
+	naFirst    bool
+}
+
+// SortOption configures Groups.Sort
+type SortOption func(*sortConfig)
+
+// Descending sorts groups in descending order of their keys
+func Descending() SortOption {
+	return func(c *sortConfig) {
+		c.descending = true
+	}
+}
+
+// NAFirst places groups whose key is NA first. They are placed last by default regardless of the order.
+func NAFirst() SortOption {
+	return func(c *sortConfig) {
+		c.naFirst = true
+	}
+}
+
+// GroupBySorted groups by the columns like GroupBy and sorts the groups by their keys like Groups.Sort,
+// so that the order of groups does not depend on the order of rows
+func (df DataFrame) GroupBySorted(names []series.Name, options ...SortOption) (Groups, error) {
+	groups, err := df.GroupBy(names...)
+	if err != nil {
+		return Groups{}, errors.Wrap(err, "failed to group by")
+	}
+	sorted, err := groups.Sort(options...)
+	if err != nil {
+		return Groups{}, errors.Wrap(err, "failed to sort groups")
+	}
+	return sorted, nil
+}
+
+// Sort returns groups sorted by their keys column by column.
+// Keys are ordered by Compare of their elements, so numbers are ordered numerically and strings lexicographically.
+func (groups Groups) Sort(options ...SortOption) (Groups, error) {
+	var config sortConfig
+	for _, option := range options {
+		option(&config)
+	}
+	var sortErr error
+	sorted := groups.SortBy(func(g1 Group, g2 Group) bool {
+		k1, k2 := g1.GetKey(), g2.GetKey()
+		for i := 0; i < k1.Len() && i < k2.Len(); i++ {
+			c, err := config.compare(k1[i].Element, k2[i].Element)
+			if err != nil {
+				if sortErr == nil {
+					sortErr = errors.Wrapf(err, "failed to compare keys, name: %s", k1[i].SeriesName)
+				}
+				return false
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return k1.Len() < k2.Len()
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+	return sorted, nil
+}
+
+// compare returns the order of the elements in the sorted groups
+func (c sortConfig) compare(e1 element.Element, e2 element.Element) (int, error) {
+	switch {
+	case e1.IsNA() && e2.IsNA():
+		return 0, nil
+	case e1.IsNA():
+		if c.naFirst {
+			return -1, nil
+		}
+		return 1, nil
+	case e2.IsNA():
+		if c.naFirst {
+			return 1, nil
+		}
+		return -1, nil
+	}
+	comparer, ok := e1.(element.Comparer)
+	if !ok {
+		return 0, fmt.Errorf("key cannot be compared, element: %v", e1)
+	}
+	order, err := comparer.Compare(e2)
+	if err != nil {
+		return 0, errors.Wrap(err, "")
+	}
+	if c.descending {
+		return -order, nil
+	}
+	return order, nil
+}
+
+// SortBy returns groups stably sorted by less. The groups are not modified.
+func (groups Groups) SortBy(less func(g1 Group, g2 Group) bool) Groups {
+	sorted := make(Groups, groups.Len())
+	copy(sorted, groups)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+	return sorted
+}
@@ -0,0 +1,138 @@
+package dataframe
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+)
+
+func TestGroups_Sort(t *testing.T) {
+	df := NewDataFrame(Columns{
+		{
+			Name: "grade",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  10,
+					IsNull: false,
+				},
+				element.NumericElement{
+					IsNull: true,
+				},
+				element.NumericElement{
+					Value:  2,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  2,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "location",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "Tokyo",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "Tokyo",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "Tokyo",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "Osaka",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "tags",
+			Elements: element.StringListElements{
+				element.StringListElement{"a"},
+				element.StringListElement{"b"},
+				element.StringListElement{"c"},
+				element.StringListElement{"d"},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	type args struct {
+		names   []series.Name
+		options []SortOption
+	}
+	tests := []struct {
+		name string
+		args
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "pass (ascending with NA last)",
+			args: args{
+				names:   []series.Name{"grade", "location"},
+				options: nil,
+			},
+			want:    [][]int{{3}, {2}, {0}, {1}},
+			wantErr: false,
+		},
+		{
+			name: "pass (descending with NA first)",
+			args: args{
+				names:   []series.Name{"grade", "location"},
+				options: []SortOption{Descending(), NAFirst()},
+			},
+			want:    [][]int{{1}, {0}, {2}, {3}},
+			wantErr: false,
+		},
+		{
+			name: "fail (uncomparable key)",
+			args: args{
+				names:   []series.Name{"tags"},
+				options: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, err := df.GroupBy(tt.args.names...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sorted, err := groups.Sort(tt.args.options...)
+			// GroupBySorted sorts the same groups at once
+			groupedSorted, groupedErr := df.GroupBySorted(tt.args.names, tt.args.options...)
+			if diff := cmp.Diff(groupedSorted.Len(), sorted.Len()); diff != "" {
+				t.Error(diff)
+			}
+			for i := range sorted {
+				if diff := cmp.Diff(groupedSorted[i], sorted[i]); diff != "" {
+					t.Error(diff)
+				}
+			}
+			if diff := cmp.Diff(groupedErr != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			var got [][]int
+			for _, g := range sorted {
+				got = append(got, g.GetIndices())
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package dataframe
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -8,6 +10,25 @@ import (
 	"github.com/hrbrain/goban/series"
 )
 
+func TestNewGroup(t *testing.T) {
+	e := element.NewStringElement("sales", false)
+	group := NewGroup("department", e, DataFrame{})
+	if diff := cmp.Diff(group.Key, Key{{SeriesName: "department", Element: e}}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(group.GetSeriesName(), series.Name("department")); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(group.GetElement(), element.Element(e)); diff != "" {
+		t.Error(diff)
+	}
+	// a literal without Key still has the key of SeriesName and Element
+	literal := Group{SeriesName: "department", Element: e}
+	if diff := cmp.Diff(literal.GetKey(), group.Key); diff != "" {
+		t.Error(diff)
+	}
+}
+
 func TestGroups_Update(t *testing.T) {
 	type fields struct {
 		groups Groups
@@ -414,6 +435,15 @@ func TestGroups_Update(t *testing.T) {
 						Value:  "element3",
 						IsNull: false,
 					},
+					Key: Key{
+						{
+							SeriesName: "series_1",
+							Element: element.StringElement{
+								Value:  "element3",
+								IsNull: false,
+							},
+						},
+					},
 					DataFrame: DataFrame{
 						Columns: Columns{
 							series.Series{
@@ -537,6 +567,20 @@ func TestGroups_Aggregate(t *testing.T) {
 			},
 			want: DataFrame{
 				Columns: Columns{
+					{
+						Name: "series_1",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "element_1",
+								IsNull: false,
+							},
+							element.StringElement{
+								Value:  "element_2",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
 					{
 						Name: "series_1",
 						Elements: element.NumericElements{
@@ -548,7 +592,7 @@ func TestGroups_Aggregate(t *testing.T) {
 								Value:  1,
 								IsNull: false,
 							},
-						},
+						}.ToNumericArray(),
 						AggregatedMethod: series.Count,
 					},
 				},
@@ -669,7 +713,7 @@ func TestGroups_Aggregate(t *testing.T) {
 								Value:  40,
 								IsNull: false,
 							},
-						},
+						}.ToNumericArray(),
 						AggregatedMethod: series.Mean,
 					},
 				},
@@ -761,6 +805,20 @@ func TestGroups_Aggregate(t *testing.T) {
 			},
 			want: DataFrame{
 				Columns: Columns{
+					{
+						Name: "series_1",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "element_1",
+								IsNull: false,
+							},
+							element.StringElement{
+								Value:  "element_2",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
 					{
 						Name: "series_2",
 						Elements: element.NumericElements{
@@ -772,7 +830,7 @@ func TestGroups_Aggregate(t *testing.T) {
 								Value:  40,
 								IsNull: false,
 							},
-						},
+						}.ToNumericArray(),
 						AggregatedMethod: series.Sum,
 					},
 				},
@@ -879,7 +937,7 @@ func TestGroups_Aggregate(t *testing.T) {
 								Value:  40,
 								IsNull: false,
 							},
-						},
+						}.ToNumericArray(),
 						AggregatedMethod: series.Mean,
 					},
 					{
@@ -966,6 +1024,186 @@ func TestGroups_Aggregate(t *testing.T) {
 			want:    DataFrame{},
 			wantErr: true,
 		},
+		{
+			name: "pass (first and count distinct)",
+			fields: fields{
+				Groups: []Group{
+					{
+						SeriesName: "department",
+						Element: element.StringElement{
+							Value:  "sales",
+							IsNull: false,
+						},
+						DataFrame: DataFrame{
+							Columns: Columns{
+								{
+									Name: "manager",
+									Elements: element.StringElements{
+										element.StringElement{
+											IsNull: true,
+										},
+										element.StringElement{
+											Value:  "Alice",
+											IsNull: false,
+										},
+										element.StringElement{
+											Value:  "Bob",
+											IsNull: false,
+										},
+										element.StringElement{
+											Value:  "Alice",
+											IsNull: false,
+										},
+									},
+									AggregatedMethod: series.None,
+								},
+							},
+							RecordCount: 4,
+						},
+					},
+				},
+			},
+			args: args{
+				AggregationConditions{
+					{
+						ColumnName: "manager",
+						Method:     series.First,
+					},
+					{
+						ColumnName: "manager",
+						Method:     series.CountDistinct,
+					},
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "department",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "sales",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "manager",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "Alice",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.First,
+					},
+					{
+						Name: "manager",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  2,
+								IsNull: false,
+							},
+						}.ToNumericArray(),
+						AggregatedMethod: series.CountDistinct,
+					},
+				},
+				RecordCount: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (collect into list column)",
+			fields: fields{
+				Groups: []Group{
+					{
+						SeriesName: "department",
+						Element: element.StringElement{
+							Value:  "sales",
+							IsNull: false,
+						},
+						DataFrame: DataFrame{
+							Columns: Columns{
+								{
+									Name: "comment",
+									Elements: element.StringElements{
+										element.StringElement{
+											Value:  "good",
+											IsNull: false,
+										},
+										element.StringElement{
+											Value:  "bad",
+											IsNull: false,
+										},
+										element.StringElement{
+											Value:  "good",
+											IsNull: false,
+										},
+									},
+									AggregatedMethod: series.None,
+								},
+							},
+							RecordCount: 3,
+						},
+					},
+					{
+						SeriesName: "department",
+						Element: element.StringElement{
+							Value:  "dev",
+							IsNull: false,
+						},
+						DataFrame: DataFrame{
+							Columns: Columns{
+								{
+									Name: "comment",
+									Elements: element.StringElements{
+										element.StringElement{
+											Value:  "fine",
+											IsNull: false,
+										},
+									},
+									AggregatedMethod: series.None,
+								},
+							},
+							RecordCount: 1,
+						},
+					},
+				},
+			},
+			args: args{
+				AggregationConditions{
+					NewAggregationCondition("comment", series.Collect).WithOptions(series.Distinct()),
+				},
+			},
+			want: DataFrame{
+				Columns: Columns{
+					{
+						Name: "department",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "sales",
+								IsNull: false,
+							},
+							element.StringElement{
+								Value:  "dev",
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "comment",
+						Elements: element.StringListElements{
+							element.StringListElement{"good", "bad"},
+							element.StringListElement{"fine"},
+						},
+						AggregatedMethod: series.Collect,
+					},
+				},
+				RecordCount: 2,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -982,3 +1220,785 @@ func TestGroups_Aggregate(t *testing.T) {
 
 	}
 }
+
+func TestGroups_Aggregate_KeyColumns(t *testing.T) {
+	df := NewDataFrame(Columns{
+		{
+			Name: "period",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "2022H1",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "2022H1",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "location",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "Tokyo",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "Osaka",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "score",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  3,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  4,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	groups, err := df.GroupBy("period", "location")
+	if err != nil {
+		t.Fatal(err)
+	}
+	score := Columns{
+		{
+			Name: "score",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  3,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  4,
+					IsNull: false,
+				},
+			}.ToNumericArray(),
+			AggregatedMethod: series.Sum,
+		},
+	}
+	tests := []struct {
+		name    string
+		options []GroupsAggregateOption
+		want    DataFrame
+	}{
+		{
+			name:    "pass (keys lead)",
+			options: nil,
+			want: NewDataFrame(append(Columns{
+				{
+					Name: "period",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "2022H1",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "2022H1",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: series.None,
+				},
+				{
+					Name: "location",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "Tokyo",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "Osaka",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: series.None,
+				},
+			}, score...)),
+		},
+		{
+			name:    "pass (without keys)",
+			options: []GroupsAggregateOption{WithoutKeys()},
+			want:    NewDataFrame(score),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := groups.Aggregate(AggregationConditions{
+				NewAggregationCondition("score", series.Sum),
+			}, tt.options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestGroups_Aggregate_AliasOfKey(t *testing.T) {
+	df := NewDataFrame(Columns{
+		{
+			Name: "location",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "Tokyo",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "score",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  3,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	groups, err := df.GroupBy("location")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = groups.Aggregate(AggregationConditions{
+		NewAggregationCondition("score", series.Max).WithAlias("location"),
+	})
+	if err == nil {
+		t.Error("alias of key column must fail")
+	}
+	// without keys, no key column is output, so the alias does not collide
+	if _, err := groups.Aggregate(AggregationConditions{
+		NewAggregationCondition("score", series.Max).WithAlias("location"),
+	}, WithoutKeys()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGroups_Aggregate_UserDefinedAllNA(t *testing.T) {
+	// longRatio is the ratio of comments longer than 3 characters, so its type differs from the column
+	longRatio := series.Aggregator{
+		Aggregate: func(s series.Series) (element.Element, error) {
+			count := 0
+			for i := 0; i < s.Len(); i++ {
+				e, err := s.GetElement(i)
+				if err != nil {
+					return nil, err
+				}
+				comment, err := e.String()
+				if err != nil {
+					return nil, err
+				}
+				if len(comment) > 3 {
+					count++
+				}
+			}
+			return element.NewNumericElement(float64(count)/float64(s.Len()), false), nil
+		},
+		NA: element.NewNumericElement(0, true),
+	}
+	method := series.AggregationMethod("LongRatio")
+	if err := series.RegisterAggregator(method, longRatio); err != nil {
+		t.Fatal(err)
+	}
+	defer series.UnregisterAggregator(method)
+
+	df := NewDataFrame(Columns{
+		{
+			Name: "department",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "dev",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "comment",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "good",
+					IsNull: false,
+				},
+				element.StringElement{
+					IsNull: true,
+				},
+				element.StringElement{
+					Value:  "ok",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	groups, err := df.GroupBy("department")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := groups.Aggregate(AggregationConditions{
+		NewAggregationCondition("comment", method),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewDataFrame(Columns{
+		{
+			Name: "department",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "dev",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "comment",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  0.5,
+					IsNull: false,
+				},
+				element.NumericElement{
+					IsNull: true,
+				},
+			}.ToNumericArray(),
+			AggregatedMethod: method,
+		},
+	})
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestGroups_Transform(t *testing.T) {
+	df := NewDataFrame(Columns{
+		{
+			Name: "department",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "dev",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "sales",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "hr",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "salary",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  100,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  500,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  200,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  300,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	groups, err := df.GroupBy("department")
+	if err != nil {
+		t.Fatal(err)
+	}
+	it := NewDataFrame(Columns{
+		{
+			Name: "salary",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  400,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	type args struct {
+		groups Groups
+		name   series.Name
+		method series.AggregationMethod
+	}
+	tests := []struct {
+		name string
+		args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				groups: groups,
+				name:   "salary",
+				method: series.Mean,
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "salary",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  150,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  500,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  150,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  300,
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: series.Mean,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "pass (rows of filtered groups are NA)",
+			args: args{
+				groups: groups.Filter(func(g Group) bool {
+					return g.GetDataframe().GetRecordCount() >= 2
+				}),
+				name:   "salary",
+				method: series.Size,
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "salary",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  2,
+							IsNull: false,
+						},
+						element.NumericElement{
+							IsNull: true,
+						},
+						element.NumericElement{
+							Value:  2,
+							IsNull: false,
+						},
+						element.NumericElement{
+							IsNull: true,
+						},
+					},
+					AggregatedMethod: series.Size,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "pass (rows of groups without indices follow)",
+			args: args{
+				groups: groups.Append(NewGroup("department", element.NewStringElement("it", false), it)),
+				name:   "salary",
+				method: series.Max,
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "salary",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  200,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  500,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  200,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  300,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  400,
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: series.Max,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "fail (column not found)",
+			args: args{
+				groups: groups,
+				name:   "bonus",
+				method: series.Mean,
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.args.groups.Transform(tt.args.name, tt.args.method)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestGroups_Apply(t *testing.T) {
+	df := NewDataFrame(Columns{
+		{
+			Name: "team",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "a",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "b",
+					IsNull: false,
+				},
+				element.StringElement{
+					Value:  "a",
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "salary",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  100,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  300,
+					IsNull: false,
+				},
+				element.NumericElement{
+					Value:  200,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	groups, err := df.GroupBy("team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		fn func(Group) (DataFrame, error)
+	}
+	tests := []struct {
+		name string
+		args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass (rank within team with NA filled columns)",
+			args: args{
+				fn: func(g Group) (DataFrame, error) {
+					salary, err := g.GetDataframe().GetColumnByNameAndMethod("salary", series.None)
+					if err != nil {
+						return DataFrame{}, err
+					}
+					salaries, err := salary.Floats()
+					if err != nil {
+						return DataFrame{}, err
+					}
+					rank := element.NumericElements{}
+					for _, s1 := range salaries {
+						r := 1
+						for _, s2 := range salaries {
+							if s2 > s1 {
+								r++
+							}
+						}
+						rank = append(rank, element.NewNumericElement(float64(r), false))
+					}
+					columns := Columns{
+						{
+							Name:             "rank",
+							Elements:         rank,
+							AggregatedMethod: series.None,
+						},
+					}
+					if g.GetDataframe().GetRecordCount() == 1 {
+						note := element.StringElements{element.NewStringElement("alone", false)}
+						columns = append(columns, series.Series{
+							Name:             "note",
+							Elements:         note,
+							AggregatedMethod: series.None,
+						})
+					}
+					return NewDataFrame(columns), nil
+				},
+			},
+			want: NewDataFrame(Columns{
+				{
+					Name: "team",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "a",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "a",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "b",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: series.None,
+				},
+				{
+					Name: "rank",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  2,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  1,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  1,
+							IsNull: false,
+						},
+					}.ToNumericArray(),
+					AggregatedMethod: series.None,
+				},
+				{
+					Name: "note",
+					Elements: element.StringElements{
+						element.StringElement{
+							IsNull: true,
+						},
+						element.StringElement{
+							IsNull: true,
+						},
+						element.StringElement{
+							Value:  "alone",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: series.None,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "fail (type mismatch between groups)",
+			args: args{
+				fn: func(g Group) (DataFrame, error) {
+					var elements element.Elements = element.NumericElements{element.NewNumericElement(1, false)}
+					if g.GetElement().Equal(element.NewStringElement("b", false)) {
+						elements = element.StringElements{element.NewStringElement("1", false)}
+					}
+					return NewDataFrame(Columns{
+						{
+							Name:             "value",
+							Elements:         elements,
+							AggregatedMethod: series.None,
+						},
+					}), nil
+				},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := groups.Apply(tt.args.fn)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestGroups_AggregateContext(t *testing.T) {
+	groups, err := benchmarkDataFrame(1000).GroupBy("department")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conditions := AggregationConditions{
+		NewAggregationCondition("salary", series.Mean),
+		NewAggregationCondition("salary", series.Max),
+	}
+	want, err := groups.Aggregate(conditions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	type args struct {
+		ctx        context.Context
+		conditions AggregationConditions
+		options    []GroupsAggregateOption
+	}
+	tests := []struct {
+		name string
+		args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass (same order as sequential)",
+			args: args{
+				ctx:        context.Background(),
+				conditions: conditions,
+				options:    []GroupsAggregateOption{WithWorkers(8)},
+			},
+			want:    want,
+			wantErr: false,
+		},
+		{
+			name: "fail (canceled)",
+			args: args{
+				ctx:        canceled,
+				conditions: conditions,
+				options:    []GroupsAggregateOption{WithWorkers(8)},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+		{
+			name: "fail (error in a group)",
+			args: args{
+				ctx: context.Background(),
+				conditions: AggregationConditions{
+					NewAggregationCondition("bonus", series.Mean),
+				},
+				options: []GroupsAggregateOption{WithWorkers(8)},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+		{
+			name: "fail (invalid worker count)",
+			args: args{
+				ctx:        context.Background(),
+				conditions: conditions,
+				options:    []GroupsAggregateOption{WithWorkers(0)},
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := groups.AggregateContext(tt.args.ctx, tt.args.conditions, tt.args.options...)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func BenchmarkGroups_Aggregate(b *testing.B) {
+	groups, err := benchmarkDataFrame(100000).GroupBy("department")
+	if err != nil {
+		b.Fatal(err)
+	}
+	conditions := AggregationConditions{
+		NewAggregationCondition("salary", series.Median),
+	}
+	for _, workers := range []int{1, 4} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := groups.Aggregate(conditions, WithWorkers(workers)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGroups_Aggregate_GroupCount(b *testing.B) {
+	conditions := AggregationConditions{
+		NewAggregationCondition("department", series.Count),
+	}
+	for _, n := range []int{10000, 20000, 40000} {
+		// salaries are unique, so each group has one row
+		groups, err := benchmarkDataFrame(n).GroupBy("salary")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("groups=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := groups.Aggregate(conditions); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package dataframe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+	"github.com/pkg/errors"
+)
+
+// JSONOrient is the layout of a dataframe in JSON
+type JSONOrient string
+
+const (
+	// RecordsOrient is a list of rows like [{"col": value}, ...]
+	RecordsOrient = JSONOrient("records")
+	// ColumnsOrient is an object of columns like {"col": [values...]}
+	ColumnsOrient = JSONOrient("columns")
+)
+
+type jsonDataFrame struct {
+	Columns []series.Series `json:"columns"`
+}
+
+// MarshalJSON encodes dataframe as a list of series keeping their types and aggregated methods
+func (df DataFrame) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonDataFrame{Columns: df.GetColumns()})
+}
+
+// UnmarshalJSON decodes dataframe encoded by MarshalJSON
+func (df *DataFrame) UnmarshalJSON(data []byte) error {
+	var jdf jsonDataFrame
+	if err := json.Unmarshal(data, &jdf); err != nil {
+		return errors.Wrap(err, "failed to unmarshal dataframe")
+	}
+	columns, err := NewColumns(nil)
+	if err != nil {
+		return errors.Wrap(err, "")
+	}
+	for _, s := range jdf.Columns {
+		columns, err = columns.Append(s)
+		if err != nil {
+			return errors.Wrap(err, "failed to append column")
+		}
+	}
+	*df = NewDataFrame(columns)
+	return nil
+}
+
+// ToJSON encodes dataframe in the orient.
+// Keys are made by HeaderName, so aggregated columns are keyed like salary_Mean.
+func (df DataFrame) ToJSON(orient JSONOrient) ([]byte, error) {
+	var buf bytes.Buffer
+	columns := df.GetColumns()
+	switch orient {
+	case RecordsOrient:
+		buf.WriteByte('[')
+		for i := 0; i < df.GetRecordCount(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONRecord(&buf, columns, i); err != nil {
+				return nil, errors.Wrap(err, "")
+			}
+		}
+		buf.WriteByte(']')
+	case ColumnsOrient:
+		buf.WriteByte('{')
+		for i, s := range columns {
+			if err := writeJSONField(&buf, i, HeaderName(s), s.Elements); err != nil {
+				return nil, errors.Wrap(err, "")
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return nil, fmt.Errorf("unsupported orient, orient: %s", orient)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeJSONRecord writes the row at index as an object
+func writeJSONRecord(buf *bytes.Buffer, columns Columns, index int) error {
+	buf.WriteByte('{')
+	for i, s := range columns {
+		e, err := s.GetElement(index)
+		if err != nil {
+			return errors.Wrap(err, "failed to get element")
+		}
+		if err := writeJSONField(buf, i, HeaderName(s), e); err != nil {
+			return errors.Wrap(err, "")
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func writeJSONField(buf *bytes.Buffer, index int, key string, value interface{}) error {
+	if index > 0 {
+		buf.WriteByte(',')
+	}
+	k, err := json.Marshal(key)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal key")
+	}
+	v, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal value, key: %s", key)
+	}
+	buf.Write(k)
+	buf.WriteByte(':')
+	buf.Write(v)
+	return nil
+}
+
+// FromJSON decodes JSON in the orient into a dataframe keeping the order of keys.
+// The type of each column is inferred from its values: numbers become numeric, strings become string,
+// booleans become bool, arrays of strings become string list and null becomes NA.
+// Missing keys in records are NA.
+func FromJSON(data []byte, orient JSONOrient) (DataFrame, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var names []series.Name
+	values := map[series.Name][]json.RawMessage{}
+	switch orient {
+	case RecordsOrient:
+		if err := expectJSONDelim(decoder, '['); err != nil {
+			return DataFrame{}, errors.Wrap(err, "")
+		}
+		for rowCount := 0; decoder.More(); rowCount++ {
+			keys, raws, err := readJSONObject(decoder)
+			if err != nil {
+				return DataFrame{}, errors.Wrapf(err, "failed to read record, row: %d", rowCount)
+			}
+			for i, key := range keys {
+				name := series.NewName(key)
+				if _, ok := values[name]; !ok {
+					names = append(names, name)
+					values[name] = make([]json.RawMessage, rowCount)
+				}
+				values[name] = append(values[name], raws[i])
+			}
+			// keys missing in this record are NA
+			for _, name := range names {
+				if len(values[name]) == rowCount {
+					values[name] = append(values[name], nil)
+				}
+			}
+		}
+		if err := expectJSONDelim(decoder, ']'); err != nil {
+			return DataFrame{}, errors.Wrap(err, "")
+		}
+	case ColumnsOrient:
+		keys, raws, err := readJSONObject(decoder)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to read columns")
+		}
+		for i, key := range keys {
+			name := series.NewName(key)
+			var columnValues []json.RawMessage
+			if err := json.Unmarshal(raws[i], &columnValues); err != nil {
+				return DataFrame{}, errors.Wrapf(err, "failed to unmarshal column, name: %s", name)
+			}
+			names = append(names, name)
+			values[name] = columnValues
+		}
+	default:
+		return DataFrame{}, fmt.Errorf("unsupported orient, orient: %s", orient)
+	}
+
+	columns, err := NewColumns(nil)
+	if err != nil {
+		return DataFrame{}, errors.Wrap(err, "")
+	}
+	for _, name := range names {
+		s, err := seriesFromJSONValues(name, values[name])
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "")
+		}
+		columns, err = columns.Append(s)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to append column")
+		}
+	}
+	return NewDataFrame(columns), nil
+}
+
+func seriesFromJSONValues(name series.Name, raws []json.RawMessage) (series.Series, error) {
+	t, err := inferJSONType(raws)
+	if err != nil {
+		return series.Series{}, errors.Wrapf(err, "failed to infer type, name: %s", name)
+	}
+	elements, err := series.UnmarshalElementsJSON(t, joinJSONValues(raws))
+	if err != nil {
+		return series.Series{}, errors.Wrapf(err, "failed to unmarshal column, name: %s", name)
+	}
+	return series.NewSeries(name, elements, series.None)
+}
+
+// unmarshalElementsJSONLike decodes the values into elements of the same type as the series
+func unmarshalElementsJSONLike(s series.Series, raws []json.RawMessage) (element.Elements, error) {
+	if enumElements, ok := s.Elements.(element.EnumElements); ok {
+		elements := element.EnumElements{Categories: enumElements.Categories}
+		if err := json.Unmarshal(joinJSONValues(raws), &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal enum elements")
+		}
+		return elements, nil
+	}
+	return series.UnmarshalElementsJSON(s.GetType(), joinJSONValues(raws))
+}
+
+// inferJSONType returns the type of the values. Values which are all null are treated as string.
+func inferJSONType(raws []json.RawMessage) (series.Type, error) {
+	inferred := series.UnknownType
+	for _, raw := range raws {
+		var t series.Type
+		switch firstJSONByte(raw) {
+		case 'n', 0:
+			continue
+		case '"':
+			t = series.StringType
+		case '[':
+			t = series.StringListType
+		case 't', 'f':
+			t = series.BoolType
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			t = series.NumericType
+		default:
+			return series.UnknownType, fmt.Errorf("unsupported value, value: %s", raw)
+		}
+		if inferred != series.UnknownType && inferred != t {
+			return series.UnknownType, fmt.Errorf("mixed types, %s and %s", inferred, t)
+		}
+		inferred = t
+	}
+	if inferred == series.UnknownType {
+		return series.StringType, nil
+	}
+	return inferred, nil
+}
+
+func firstJSONByte(raw json.RawMessage) byte {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return 0
+	}
+	return trimmed[0]
+}
+
+// joinJSONValues makes a JSON array from the values. nil values are written as null.
+func joinJSONValues(raws []json.RawMessage) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, raw := range raws {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if raw == nil {
+			buf.WriteString("null")
+			continue
+		}
+		buf.Write(raw)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes()
+}
+
+func expectJSONDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return errors.Wrap(err, "failed to read token")
+	}
+	if token != delim {
+		return fmt.Errorf("unexpected token, expected: %s, token: %v", delim, token)
+	}
+	return nil
+}
+
+// readJSONObject reads an object keeping the order of its keys
+func readJSONObject(decoder *json.Decoder) ([]string, []json.RawMessage, error) {
+	if err := expectJSONDelim(decoder, '{'); err != nil {
+		return nil, nil, errors.Wrap(err, "")
+	}
+	var keys []string
+	var raws []json.RawMessage
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to read key")
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected key, key: %v", token)
+		}
+		for _, k := range keys {
+			if k == key {
+				return nil, nil, fmt.Errorf("duplicated key, key: %s", key)
+			}
+		}
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to read value, key: %s", key)
+		}
+		keys = append(keys, key)
+		raws = append(raws, raw)
+	}
+	if err := expectJSONDelim(decoder, '}'); err != nil {
+		return nil, nil, errors.Wrap(err, "")
+	}
+	return keys, raws, nil
+}
@@ -0,0 +1,260 @@
+package dataframe
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+)
+
+func TestDataFrame_ToJSON(t *testing.T) {
+	type fields struct {
+		DataFrame
+	}
+	type args struct {
+		orient JSONOrient
+	}
+	df := NewDataFrame(Columns{
+		{
+			Name: "name",
+			Elements: element.StringElements{
+				element.StringElement{
+					Value:  "Alice",
+					IsNull: false,
+				},
+				element.StringElement{
+					IsNull: true,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+		{
+			Name: "salary",
+			Elements: element.NumericElements{
+				element.NumericElement{
+					Value:  100.5,
+					IsNull: false,
+				},
+				element.NumericElement{
+					IsNull: true,
+				},
+			},
+			AggregatedMethod: series.Mean,
+		},
+		{
+			Name: "tags",
+			Elements: element.StringListElements{
+				element.StringListElement{"a", "b"},
+				element.StringListElement{},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	tests := []struct {
+		name string
+		fields
+		args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pass (records)",
+			fields: fields{
+				df,
+			},
+			args: args{
+				orient: RecordsOrient,
+			},
+			want:    `[{"name":"Alice","salary_Mean":100.5,"tags":["a","b"]},{"name":null,"salary_Mean":null,"tags":null}]`,
+			wantErr: false,
+		},
+		{
+			name: "pass (columns)",
+			fields: fields{
+				df,
+			},
+			args: args{
+				orient: ColumnsOrient,
+			},
+			want:    `{"name":["Alice",null],"salary_Mean":[100.5,null],"tags":[["a","b"],null]}`,
+			wantErr: false,
+		},
+		{
+			name: "fail (unsupported orient)",
+			fields: fields{
+				df,
+			},
+			args: args{
+				orient: JSONOrient("index"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.ToJSON(tt.args.orient)
+			if diff := cmp.Diff(string(got), tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestFromJSON(t *testing.T) {
+	type args struct {
+		data   string
+		orient JSONOrient
+	}
+	want := DataFrame{
+		Columns: Columns{
+			{
+				Name: "name",
+				Elements: element.StringElements{
+					element.StringElement{
+						Value:  "Alice",
+						IsNull: false,
+					},
+					element.StringElement{
+						IsNull: true,
+					},
+				},
+				AggregatedMethod: series.None,
+			},
+			{
+				Name: "salary",
+				Elements: element.NumericArray{
+					Values:   []float64{100, 0},
+					Validity: element.Bitmap{^uint64(1 << 1)},
+				},
+				AggregatedMethod: series.None,
+			},
+			{
+				Name: "tags",
+				Elements: element.StringListElements{
+					element.StringListElement{"a"},
+					element.StringListElement{},
+				},
+				AggregatedMethod: series.None,
+			},
+		},
+		RecordCount: 2,
+	}
+	tests := []struct {
+		name string
+		args
+		want    DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass (records with missing keys)",
+			args: args{
+				data:   `[{"name":"Alice","salary":100,"tags":["a"]},{"salary":null}]`,
+				orient: RecordsOrient,
+			},
+			want:    want,
+			wantErr: false,
+		},
+		{
+			name: "pass (columns)",
+			args: args{
+				data:   `{"name":["Alice",null],"salary":[100,null],"tags":[["a"],null]}`,
+				orient: ColumnsOrient,
+			},
+			want:    want,
+			wantErr: false,
+		},
+		{
+			name: "fail (mixed types)",
+			args: args{
+				data:   `[{"name":"Alice"},{"name":1}]`,
+				orient: RecordsOrient,
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+		{
+			name: "fail (length mismatch)",
+			args: args{
+				data:   `{"name":["Alice"],"salary":[100,200]}`,
+				orient: ColumnsOrient,
+			},
+			want:    DataFrame{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromJSON([]byte(tt.args.data), tt.args.orient)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDataFrame_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		DataFrame
+	}{
+		{
+			name: "pass (aggregated dataframe round trips)",
+			DataFrame: NewDataFrame(Columns{
+				{
+					Name: "department",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "sales",
+							IsNull: false,
+						},
+						element.StringElement{
+							IsNull: true,
+						},
+					},
+					AggregatedMethod: series.None,
+				},
+				{
+					Name: "salary",
+					Elements: element.NumericArray{
+						Values:   []float64{100, 0},
+						Validity: element.Bitmap{^uint64(1 << 1)},
+					},
+					AggregatedMethod: series.Mean,
+				},
+				{
+					Name: "salary",
+					Elements: element.NumericArray{
+						Values: []float64{2, 1},
+					},
+					AggregatedMethod: series.Count,
+				},
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.DataFrame)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got DataFrame
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.DataFrame); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
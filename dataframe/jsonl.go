@@ -0,0 +1,131 @@
+package dataframe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/pkg/errors"
+)
+
+// JSONLReader reads JSON Lines into dataframes of at most chunkSize records
+type JSONLReader struct {
+	decoder   *json.Decoder
+	schema    DataFrame
+	chunkSize int
+	// decimalScales are the scales of decimal columns of the schema by column index
+	decimalScales map[int]int32
+}
+
+// NewJSONLReader returns a reader which types every chunk by the columns of the schema.
+// Keys are matched with HeaderName of each column. Missing keys are NA and unknown keys are ignored.
+// Decimal columns are decoded in the largest scale of the elements of the schema column,
+// or of the values in each chunk if the schema column is empty.
+func NewJSONLReader(r io.Reader, schema DataFrame, chunkSize int) (*JSONLReader, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size, chunkSize: %d", chunkSize)
+	}
+	decimalScales := map[int]int32{}
+	for i, s := range schema.GetColumns() {
+		if decimalElements, ok := s.Elements.(element.DecimalElements); ok && decimalElements.Len() > 0 {
+			decimalScales[i] = decimalElements.Scale()
+		}
+	}
+	emptySchema, err := schema.Delete()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete schema")
+	}
+	return &JSONLReader{
+		decoder:       json.NewDecoder(r),
+		schema:        emptySchema,
+		chunkSize:     chunkSize,
+		decimalScales: decimalScales,
+	}, nil
+}
+
+// Read returns the next chunk. It returns io.EOF when no record is left.
+func (r *JSONLReader) Read() (DataFrame, error) {
+	columns := r.schema.GetColumns()
+	values := make([][]json.RawMessage, columns.Len())
+	indexes := make(map[string]int, columns.Len())
+	for i, s := range columns {
+		indexes[HeaderName(s)] = i
+	}
+
+	rowCount := 0
+	for ; rowCount < r.chunkSize; rowCount++ {
+		if !r.decoder.More() {
+			break
+		}
+		keys, raws, err := readJSONObject(r.decoder)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to read record")
+		}
+		for i := range values {
+			values[i] = append(values[i], nil)
+		}
+		for i, key := range keys {
+			if index, ok := indexes[key]; ok {
+				values[index][rowCount] = raws[i]
+			}
+		}
+	}
+	if rowCount == 0 {
+		// More returns false both at the end and on a read error
+		token, err := r.decoder.Token()
+		if err == io.EOF {
+			return DataFrame{}, io.EOF
+		}
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to read json lines")
+		}
+		return DataFrame{}, fmt.Errorf("unexpected token, token: %v", token)
+	}
+
+	newColumns := make(Columns, columns.Len())
+	for i, s := range columns {
+		elements, err := unmarshalElementsJSONLike(s, values[i])
+		if err != nil {
+			return DataFrame{}, errors.Wrapf(err, "failed to unmarshal column, name: %s", s.GetName())
+		}
+		if scale, ok := r.decimalScales[i]; ok {
+			elements, err = elements.(element.DecimalElements).Rescale(scale)
+			if err != nil {
+				return DataFrame{}, errors.Wrapf(err, "failed to rescale column, name: %s", s.GetName())
+			}
+		}
+		newColumns[i], err = s.UpdateElements(elements)
+		if err != nil {
+			return DataFrame{}, errors.Wrap(err, "failed to update elements")
+		}
+	}
+	return NewDataFrame(newColumns), nil
+}
+
+// JSONLWriter writes dataframes as JSON Lines
+type JSONLWriter struct {
+	w io.Writer
+}
+
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{w: w}
+}
+
+// Write writes one object per record. Keys are made by HeaderName.
+func (w *JSONLWriter) Write(df DataFrame) error {
+	var buf bytes.Buffer
+	columns := df.GetColumns()
+	for i := 0; i < df.GetRecordCount(); i++ {
+		if err := writeJSONRecord(&buf, columns, i); err != nil {
+			return errors.Wrap(err, "")
+		}
+		buf.WriteByte('\n')
+		if _, err := w.w.Write(buf.Bytes()); err != nil {
+			return errors.Wrap(err, "failed to write record")
+		}
+		buf.Reset()
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package dataframe
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+)
+
+func TestJSONLReader_Read(t *testing.T) {
+	schema := DataFrame{
+		Columns: Columns{
+			{
+				Name:             "department",
+				Elements:         element.StringElements{},
+				AggregatedMethod: series.None,
+			},
+			{
+				Name:             "score",
+				Elements:         element.NumericElements{},
+				AggregatedMethod: series.None,
+			},
+		},
+	}
+	type args struct {
+		input     string
+		chunkSize int
+	}
+	tests := []struct {
+		name string
+		args
+		want    []DataFrame
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				input: `{"department":"sales","score":3}` + "\n" +
+					`{"department":"dev","score":null,"comment":"ignored"}` + "\n" +
+					`{"score":5}` + "\n",
+				chunkSize: 2,
+			},
+			want: []DataFrame{
+				{
+					Columns: Columns{
+						{
+							Name: "department",
+							Elements: element.StringElements{
+								element.StringElement{
+									Value:  "sales",
+									IsNull: false,
+								},
+								element.StringElement{
+									Value:  "dev",
+									IsNull: false,
+								},
+							},
+							AggregatedMethod: series.None,
+						},
+						{
+							Name: "score",
+							Elements: element.NumericArray{
+								Values:   []float64{3, 0},
+								Validity: element.Bitmap{^uint64(1 << 1)},
+							},
+							AggregatedMethod: series.None,
+						},
+					},
+					RecordCount: 2,
+				},
+				{
+					Columns: Columns{
+						{
+							Name: "department",
+							Elements: element.StringElements{
+								element.StringElement{
+									IsNull: true,
+								},
+							},
+							AggregatedMethod: series.None,
+						},
+						{
+							Name: "score",
+							Elements: element.NumericArray{
+								Values: []float64{5},
+							},
+							AggregatedMethod: series.None,
+						},
+					},
+					RecordCount: 1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (type mismatch)",
+			args: args{
+				input:     `{"department":"sales","score":"high"}` + "\n",
+				chunkSize: 2,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "fail (broken json)",
+			args: args{
+				input:     `{"department":"sales"` + "\n",
+				chunkSize: 2,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := NewJSONLReader(strings.NewReader(tt.args.input), schema, tt.args.chunkSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []DataFrame
+			for {
+				var chunk DataFrame
+				chunk, err = reader.Read()
+				if err != nil {
+					break
+				}
+				got = append(got, chunk)
+			}
+			if err == io.EOF {
+				err = nil
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestJSONLReader_Read_DecimalScale(t *testing.T) {
+	schema := NewDataFrame(Columns{
+		{
+			Name: "price",
+			Elements: element.DecimalElements{
+				element.DecimalElement{
+					Value:  0,
+					Scale:  2,
+					IsNull: false,
+				},
+			},
+			AggregatedMethod: series.None,
+		},
+	})
+	reader, err := NewJSONLReader(strings.NewReader(`{"price":"1"}`+"\n"+`{"price":null}`+"\n"+`{"price":"2.5"}`+"\n"), schema, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []DataFrame
+	for {
+		chunk, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, chunk)
+	}
+	want := []DataFrame{
+		NewDataFrame(Columns{
+			{
+				Name: "price",
+				Elements: element.DecimalElements{
+					element.DecimalElement{
+						Value:  100,
+						Scale:  2,
+						IsNull: false,
+					},
+					element.DecimalElement{
+						Scale:  2,
+						IsNull: true,
+					},
+				},
+				AggregatedMethod: series.None,
+			},
+		}),
+		NewDataFrame(Columns{
+			{
+				Name: "price",
+				Elements: element.DecimalElements{
+					element.DecimalElement{
+						Value:  250,
+						Scale:  2,
+						IsNull: false,
+					},
+				},
+				AggregatedMethod: series.None,
+			},
+		}),
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestJSONLWriter_Write(t *testing.T) {
+	type args struct {
+		DataFrame
+	}
+	tests := []struct {
+		name string
+		args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				NewDataFrame(Columns{
+					{
+						Name: "department",
+						Elements: element.StringElements{
+							element.StringElement{
+								Value:  "sales",
+								IsNull: false,
+							},
+							element.StringElement{
+								IsNull: true,
+							},
+						},
+						AggregatedMethod: series.None,
+					},
+					{
+						Name: "score",
+						Elements: element.NumericElements{
+							element.NumericElement{
+								Value:  3.5,
+								IsNull: false,
+							},
+							element.NumericElement{
+								Value:  4,
+								IsNull: false,
+							},
+						},
+						AggregatedMethod: series.Mean,
+					},
+				}),
+			},
+			want: `{"department":"sales","score_Mean":3.5}` + "\n" +
+				`{"department":null,"score_Mean":4}` + "\n",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := NewJSONLWriter(&buf).Write(tt.args.DataFrame)
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dataframe
+
+import (
+	"github.com/hrbrain/goban/element"
+	"github.com/hrbrain/goban/series"
+)
+
+// KeyElement is an element of a group key with the name of its column
+type KeyElement struct {
+	SeriesName series.Name
+	Element    element.Element
+}
+
+// Key is the composite key of a group in the order of the grouped columns
+type Key []KeyElement
+
+func NewKey(keyElements []KeyElement) Key {
+	return keyElements
+}
+
+func (k Key) Len() int {
+	return len(k)
+}
+
+// Names returns the names of the grouped columns
+func (k Key) Names() []series.Name {
+	names := make([]series.Name, 0, k.Len())
+	for _, ke := range k {
+		names = append(names, ke.SeriesName)
+	}
+	return names
+}
+
+// Equal reports whether both keys have the same columns and elements. NA equals NA.
+func (k Key) Equal(k2 Key) bool {
+	if k.Len() != k2.Len() {
+		return false
+	}
+	for i, ke := range k {
+		if ke.SeriesName != k2[i].SeriesName || !ke.Element.Equal(k2[i].Element) {
+			return false
+		}
+	}
+	return true
+}
@@ -13,6 +13,24 @@ func NewRecord() Record {
 	return map[series.Name]element.Element{}
 }
 
+// Field is a series name and its element in a record
+type Field struct {
+	Name    series.Name
+	Element element.Element
+}
+
+// NewRecordFromFields makes a record of the fields at once. It fails if a series name is duplicated.
+// Use it instead of AddField to build a record of many fields, as AddField copies the record for each field.
+func NewRecordFromFields(fields ...Field) (Record, error) {
+	r := make(Record, len(fields))
+	for _, f := range fields {
+		if err := r.addField(f.Name, f.Element); err != nil {
+			return Record{}, err
+		}
+	}
+	return r, nil
+}
+
 // GetSeriesNames does not guarantee the order of series names
 func (r Record) GetSeriesNames() []series.Name {
 	names := make([]series.Name, 0, len(r))
@@ -41,12 +59,28 @@ func (r Record) HasNAElement(names ...series.Name) bool {
 	return false
 }
 
+// AddField returns a record with the field added. The receiver is not modified,
+// so the returned record must be used instead. As it copies the record for each field,
+// building a record of k fields by AddField takes O(k²) time; use NewRecordFromFields for many fields.
 func (r Record) AddField(name series.Name, e element.Element) (Record, error) {
 	if r.Has(name) {
 		return Record{}, fmt.Errorf("duplicated series name, name: %s", name)
 	}
+	newRecord := make(Record, len(r)+1)
+	for n, v := range r {
+		newRecord[n] = v
+	}
+	newRecord[name] = e
+	return newRecord, nil
+}
+
+// addField adds the field into the record in place. It is only used for records which are not shared yet.
+func (r Record) addField(name series.Name, e element.Element) error {
+	if r.Has(name) {
+		return fmt.Errorf("duplicated series name, name: %s", name)
+	}
 	r[name] = e
-	return r, nil
+	return nil
 }
 
 func (r Record) GetElement(name series.Name) (element.Element, error) {
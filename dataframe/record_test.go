@@ -140,6 +140,79 @@ func TestRecord_AddField(t *testing.T) {
 	}
 }
 
+func TestNewRecordFromFields(t *testing.T) {
+	tests := []struct {
+		name      string
+		fields    []Field
+		want      Record
+		wantError bool
+	}{
+		{
+			name: "pass",
+			fields: []Field{
+				{
+					Name: "series_1",
+					Element: element.StringElement{
+						Value:  "abc",
+						IsNull: false,
+					},
+				},
+				{
+					Name: "series_2",
+					Element: element.NumericElement{
+						Value:  123,
+						IsNull: false,
+					},
+				},
+			},
+			want: Record{
+				"series_1": element.StringElement{
+					Value:  "abc",
+					IsNull: false,
+				},
+				"series_2": element.NumericElement{
+					Value:  123,
+					IsNull: false,
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "fail (duplicated series name)",
+			fields: []Field{
+				{
+					Name: "series_1",
+					Element: element.StringElement{
+						Value:  "abc",
+						IsNull: false,
+					},
+				},
+				{
+					Name: "series_1",
+					Element: element.NumericElement{
+						Value:  123,
+						IsNull: false,
+					},
+				},
+			},
+			want:      Record{},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRecordFromFields(tt.fields...)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantError); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
 func TestRecord_GetElement(t *testing.T) {
 	type fields struct {
 		Record
@@ -0,0 +1,57 @@
+package element
+
+// Bitmap is a validity bitmap in which the bit of each valid value is set.
+// A nil bitmap means that every value is valid, so columns without NA need no bitmap at all.
+type Bitmap []uint64
+
+const bitmapWordSize = 64
+
+// bitmapWords returns the number of words which hold n bits
+func bitmapWords(n int) int {
+	return (n + bitmapWordSize - 1) / bitmapWordSize
+}
+
+// NewBitmap returns a bitmap of n bits in which every bit is set
+func NewBitmap(n int) Bitmap {
+	b := make(Bitmap, bitmapWords(n))
+	for i := range b {
+		b[i] = ^uint64(0)
+	}
+	return b
+}
+
+// IsValid reports whether the bit at index is set. Every index is valid in a nil bitmap.
+func (b Bitmap) IsValid(index int) bool {
+	if b == nil {
+		return true
+	}
+	return b[index/bitmapWordSize]&(1<<(uint(index)%bitmapWordSize)) != 0
+}
+
+// Set sets the bit at index in place
+func (b Bitmap) Set(index int) {
+	b[index/bitmapWordSize] |= 1 << (uint(index) % bitmapWordSize)
+}
+
+// Clear clears the bit at index in place
+func (b Bitmap) Clear(index int) {
+	b[index/bitmapWordSize] &^= 1 << (uint(index) % bitmapWordSize)
+}
+
+// grow returns a bitmap which holds n bits. New bits are set.
+func (b Bitmap) grow(n int) Bitmap {
+	for len(b) < bitmapWords(n) {
+		b = append(b, ^uint64(0))
+	}
+	return b
+}
+
+// clone returns a copy of the first n bits of the bitmap
+func (b Bitmap) clone(n int) Bitmap {
+	if b == nil {
+		return nil
+	}
+	cloned := make(Bitmap, bitmapWords(n))
+	copy(cloned, b)
+	return cloned
+}
@@ -0,0 +1,96 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type BoolElement struct {
+	Value  bool
+	IsNull bool
+}
+
+func NewBoolElement(b bool, isNull bool) BoolElement {
+	return BoolElement{b, isNull}
+}
+
+// Float convert bool element to 1 if true and 0 if false
+func (be BoolElement) Float() (float64, error) {
+	if be.IsNA() {
+		return 0, fmt.Errorf("can't convert NA to float")
+	}
+	if be.Value {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// String convert bool element to "true" or "false"
+func (be BoolElement) String() (string, error) {
+	if be.IsNA() {
+		return "", nil
+	}
+	return strconv.FormatBool(be.Value), nil
+}
+
+// ToElements return elements which contains only one element
+func (be BoolElement) ToElements() Elements {
+	return BoolElements{be}
+}
+
+// Equal compare two elements
+func (be BoolElement) Equal(e Element) bool {
+	// If both elements are NA, they are same no matter what value they have
+	if be.IsNA() && e.IsNA() {
+		return true
+	}
+	return be == e
+}
+
+// IsNA return true if element is NA
+func (be BoolElement) IsNA() bool {
+	return be.IsNull
+}
+
+// Compare compare two bool elements. false is less than true.
+func (be BoolElement) Compare(e Element) (int, error) {
+	be2, ok := e.(BoolElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if be.IsNA() || be2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	switch {
+	case be.Value == be2.Value:
+		return 0, nil
+	case be2.Value:
+		return -1, nil
+	}
+	return 1, nil
+}
+
+// MarshalJSON encodes bool element as a JSON boolean, or null if it is NA
+func (be BoolElement) MarshalJSON() ([]byte, error) {
+	if be.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(be.Value)
+}
+
+// UnmarshalJSON decodes a JSON boolean into bool element. null is decoded as NA.
+func (be *BoolElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*be = NewBoolElement(false, true)
+		return nil
+	}
+	var b bool
+	if err := json.Unmarshal(data, &b); err != nil {
+		return errors.Wrap(err, "failed to unmarshal bool element")
+	}
+	*be = NewBoolElement(b, false)
+	return nil
+}
@@ -0,0 +1,136 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBoolElement_Float(t *testing.T) {
+	type fields struct {
+		boolElement BoolElement
+	}
+	tests := []struct {
+		name string
+		fields
+		want    float64
+		wantErr bool
+	}{
+		{
+			name: "pass (true)",
+			fields: fields{
+				BoolElement{
+					Value:  true,
+					IsNull: false,
+				},
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "pass (false)",
+			fields: fields{
+				BoolElement{
+					Value:  false,
+					IsNull: false,
+				},
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "fail (null)",
+			fields: fields{
+				BoolElement{
+					IsNull: true,
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.boolElement.Float()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestBoolElement_Equal(t *testing.T) {
+	type fields struct {
+		boolElement BoolElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				boolElement: BoolElement{
+					Value:  true,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: BoolElement{
+					Value:  true,
+					IsNull: false,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "pass (both elements are null)",
+			fields: fields{
+				boolElement: BoolElement{
+					Value:  true,
+					IsNull: true,
+				},
+			},
+			args: args{
+				element: BoolElement{
+					Value:  false,
+					IsNull: true,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "fail (type mismatch)",
+			fields: fields{
+				boolElement: BoolElement{
+					Value:  true,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: NumericElement{
+					Value:  1,
+					IsNull: false,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.boolElement.Equal(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type BoolElements []BoolElement
+
+func NewBoolElements(boolElements []BoolElement) BoolElements {
+	return boolElements
+}
+
+// Len returns the length of the elements.
+func (be BoolElements) Len() int {
+	return len(be)
+}
+
+// GetElement returns the element at the given index.
+func (be BoolElements) GetElement(index int) (Element, error) {
+	if index < 0 || be.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	return be[index], nil
+}
+
+// AddElement adds the given element to the elements.
+func (be BoolElements) AddElement(e Element) (Elements, error) {
+	boolElement, ok := e.(BoolElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	return append(be, boolElement), nil
+}
+
+// Floats convert bool elements into 1 for true and 0 for false
+func (be BoolElements) Floats() ([]float64, error) {
+	floats := make([]float64, be.Len())
+	for i, boolElement := range be {
+		floatValue, err := boolElement.Float()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert to float value")
+		}
+		floats[i] = floatValue
+	}
+	return floats, nil
+}
+
+// GetGroupedElement returns a single element if all the elements are the same.
+func (be BoolElements) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i, element := range be {
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			err := fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+			return nil, err
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns an empty elements.
+func (be BoolElements) Delete() Elements {
+	return BoolElements{}
+}
+
+// Clone returns a copy of the elements which does not share its backing array
+func (be BoolElements) Clone() Elements {
+	if be == nil {
+		return be
+	}
+	cloned := make(BoolElements, len(be))
+	copy(cloned, be)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (be BoolElements) Clip() Elements {
+	return be[:len(be):len(be)]
+}
+
+// Append the given elements to the elements.
+func (be BoolElements) Append(elements2 Elements) (Elements, error) {
+	boolElements2, ok := elements2.(BoolElements)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+	}
+	return append(be, boolElements2...), nil
+}
+
+// Mask returns the elements as a slice of bool. NA elements are false.
+func (be BoolElements) Mask() []bool {
+	mask := make([]bool, be.Len())
+	for i, boolElement := range be {
+		mask[i] = !boolElement.IsNA() && boolElement.Value
+	}
+	return mask
+}
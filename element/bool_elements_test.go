@@ -0,0 +1,101 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBoolElements_Floats(t *testing.T) {
+	type fields struct {
+		boolElements BoolElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want    []float64
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				BoolElements{
+					BoolElement{
+						Value:  true,
+						IsNull: false,
+					},
+					BoolElement{
+						Value:  false,
+						IsNull: false,
+					},
+				},
+			},
+			want:    []float64{1, 0},
+			wantErr: false,
+		},
+		{
+			name: "fail (null)",
+			fields: fields{
+				BoolElements{
+					BoolElement{
+						IsNull: true,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.boolElements.Floats()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestBoolElements_Mask(t *testing.T) {
+	type fields struct {
+		boolElements BoolElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want []bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				BoolElements{
+					BoolElement{
+						Value:  true,
+						IsNull: false,
+					},
+					BoolElement{
+						Value:  false,
+						IsNull: false,
+					},
+					BoolElement{
+						Value:  true,
+						IsNull: true,
+					},
+				},
+			},
+			want: []bool{true, false, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.boolElements.Mask()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package element
+
+import (
+	"fmt"
+)
+
+// Categories is an ordered set of values which enum elements can take.
+// The order of values is the order of enum elements.
+type Categories []string
+
+// NewCategories returns categories in the given order. Duplicated values are not allowed.
+func NewCategories(values []string) (Categories, error) {
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			return nil, fmt.Errorf("duplicated category, value: %s", value)
+		}
+		seen[value] = true
+	}
+	return values, nil
+}
+
+// Code returns the position of the value in categories
+func (c Categories) Code(value string) (int32, bool) {
+	for i, v := range c {
+		if v == value {
+			return int32(i), true
+		}
+	}
+	return 0, false
+}
+
+// Value returns the value at the code
+func (c Categories) Value(code int32) (string, error) {
+	if code < 0 || int(code) >= len(c) {
+		return "", fmt.Errorf("code out of range, code: %d", code)
+	}
+	return c[code], nil
+}
+
+// Equal returns true if both categories have the same values in the same order
+func (c Categories) Equal(c2 Categories) bool {
+	if len(c) != len(c2) {
+		return false
+	}
+	for i := range c {
+		if c[i] != c2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NewElement returns an enum element of the value. It fails if the value is not in categories.
+func (c Categories) NewElement(value string) (EnumElement, error) {
+	code, ok := c.Code(value)
+	if !ok {
+		return EnumElement{}, fmt.Errorf("value is not in categories, value: %s", value)
+	}
+	return NewEnumElement(code, c, false), nil
+}
@@ -0,0 +1,48 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewCategories(t *testing.T) {
+	type args struct {
+		values []string
+	}
+	tests := []struct {
+		name string
+		args
+		want    Categories
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				values: []string{"S", "A", "B", "C"},
+			},
+			want:    Categories{"S", "A", "B", "C"},
+			wantErr: false,
+		},
+		{
+			name: "fail (duplicated value)",
+			args: args{
+				values: []string{"S", "A", "S"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCategories(tt.args.values)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
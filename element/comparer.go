@@ -0,0 +1,7 @@
+package element
+
+// Comparer is implemented by elements which have an order.
+// Compare returns a negative number if the element is less than e, zero if equal and a positive number if greater.
+type Comparer interface {
+	Compare(e Element) (int, error)
+}
@@ -0,0 +1,174 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MaxDecimalScale is the largest scale whose unit fits in int64
+const MaxDecimalScale = 18
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d{1,3}(,\d{3})+|\d+)(\.\d+)?$`)
+
+// DecimalElement holds a fixed-point decimal, Value / 10^Scale, for monetary values.
+// For example, 1234.50 USD is {Value: 123450, Scale: 2} and 1234 yen is {Value: 1234, Scale: 0}.
+type DecimalElement struct {
+	Value  int64
+	Scale  int32
+	IsNull bool
+}
+
+func NewDecimalElement(value int64, scale int32, isNull bool) DecimalElement {
+	return DecimalElement{value, scale, isNull}
+}
+
+// ParseDecimal parse string like "1,234.5" into decimal element of the scale.
+// It fails if the string has more fractional digits than the scale.
+func ParseDecimal(s string, scale int32) (DecimalElement, error) {
+	if scale < 0 || scale > MaxDecimalScale {
+		return DecimalElement{}, fmt.Errorf("invalid scale, scale: %d", scale)
+	}
+	if !decimalPattern.MatchString(s) {
+		return DecimalElement{}, fmt.Errorf("invalid decimal, s: %s", s)
+	}
+	integerPart, fractionPart, _ := strings.Cut(strings.ReplaceAll(s, ",", ""), ".")
+	if len(fractionPart) > int(scale) {
+		return DecimalElement{}, fmt.Errorf("too many fractional digits for scale, s: %s, scale: %d", s, scale)
+	}
+	unscaled, ok := new(big.Int).SetString(integerPart+fractionPart+strings.Repeat("0", int(scale)-len(fractionPart)), 10)
+	if !ok {
+		return DecimalElement{}, fmt.Errorf("invalid decimal, s: %s", s)
+	}
+	return newDecimalElementFromBig(unscaled, scale)
+}
+
+func newDecimalElementFromBig(unscaled *big.Int, scale int32) (DecimalElement, error) {
+	if !unscaled.IsInt64() {
+		return DecimalElement{}, fmt.Errorf("decimal overflow, value: %s, scale: %d", unscaled, scale)
+	}
+	return NewDecimalElement(unscaled.Int64(), scale, false), nil
+}
+
+// Float convert decimal element to float. The result may not be exact.
+func (de DecimalElement) Float() (float64, error) {
+	if de.IsNA() {
+		return 0, fmt.Errorf("can't convert NA to float")
+	}
+	f, _ := de.rat().Float64()
+	return f, nil
+}
+
+// String convert decimal element to string with as many fractional digits as its scale
+func (de DecimalElement) String() (string, error) {
+	if de.IsNA() {
+		return "", nil
+	}
+	return de.rat().FloatString(int(de.Scale)), nil
+}
+
+// ToElements return elements which contains only one element
+func (de DecimalElement) ToElements() Elements {
+	return DecimalElements{de}
+}
+
+// Equal compare two elements. Decimals of different scales are equal if they are the same number.
+func (de DecimalElement) Equal(e Element) bool {
+	// If both elements are NA, they are same no matter what value they have
+	if de.IsNA() && e.IsNA() {
+		return true
+	}
+	c, err := de.Compare(e)
+	return err == nil && c == 0
+}
+
+// IsNA return true if element is NA
+func (de DecimalElement) IsNA() bool {
+	return de.IsNull
+}
+
+// Compare compare two decimal elements as numbers
+func (de DecimalElement) Compare(e Element) (int, error) {
+	de2, ok := e.(DecimalElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if de.IsNA() || de2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	return de.rat().Cmp(de2.rat()), nil
+}
+
+// Rescale returns decimal element of the scale, rounded by the mode if the scale is smaller
+func (de DecimalElement) Rescale(scale int32, mode RoundingMode) (DecimalElement, error) {
+	if de.IsNA() {
+		return NewDecimalElement(0, scale, true), nil
+	}
+	return newDecimalElementFromRat(de.rat(), scale, mode)
+}
+
+// ToNumericElement convert decimal element to numeric element. The result may not be exact.
+func (de DecimalElement) ToNumericElement() NumericElement {
+	if de.IsNA() {
+		return NewNumericElement(0, true)
+	}
+	f, _ := de.Float()
+	return NewNumericElement(f, false)
+}
+
+func (de DecimalElement) rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(de.Value), pow10(de.Scale))
+}
+
+func newDecimalElementFromRat(r *big.Rat, scale int32, mode RoundingMode) (DecimalElement, error) {
+	if scale < 0 || scale > MaxDecimalScale {
+		return DecimalElement{}, fmt.Errorf("invalid scale, scale: %d", scale)
+	}
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale)))
+	unscaled, err := mode.round(scaled)
+	if err != nil {
+		return DecimalElement{}, errors.Wrap(err, "failed to round")
+	}
+	return newDecimalElementFromBig(unscaled, scale)
+}
+
+func pow10(scale int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+}
+
+// MarshalJSON encodes decimal element as a JSON string like "1234.50" to keep it exact, or null if it is NA
+func (de DecimalElement) MarshalJSON() ([]byte, error) {
+	if de.IsNA() {
+		return []byte("null"), nil
+	}
+	s, err := de.String()
+	if err != nil {
+		return nil, errors.Wrap(err, "")
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON decodes a JSON string or number into decimal element.
+// The scale is the number of fractional digits, and null is decoded as NA of scale 0.
+// Decode elements by DecimalElements.UnmarshalJSON or rescale them to keep the scale of a series.
+func (de *DecimalElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*de = NewDecimalElement(0, 0, true)
+		return nil
+	}
+	var s json.Number
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(err, "failed to unmarshal decimal element")
+	}
+	_, fractionPart, _ := strings.Cut(s.String(), ".")
+	decimalElement, err := ParseDecimal(s.String(), int32(len(fractionPart)))
+	if err != nil {
+		return errors.Wrap(err, "failed to parse decimal")
+	}
+	*de = decimalElement
+	return nil
+}
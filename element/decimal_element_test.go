@@ -0,0 +1,194 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseDecimal(t *testing.T) {
+	type args struct {
+		s     string
+		scale int32
+	}
+	tests := []struct {
+		name string
+		args
+		want    DecimalElement
+		wantErr bool
+	}{
+		{
+			name: "pass (thousands separators)",
+			args: args{
+				s:     "1,234,567.8",
+				scale: 2,
+			},
+			want: DecimalElement{
+				Value:  123456780,
+				Scale:  2,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (negative yen)",
+			args: args{
+				s:     "-500",
+				scale: 0,
+			},
+			want: DecimalElement{
+				Value:  -500,
+				Scale:  0,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (too many fractional digits)",
+			args: args{
+				s:     "1.005",
+				scale: 2,
+			},
+			want:    DecimalElement{},
+			wantErr: true,
+		},
+		{
+			name: "fail (misplaced separator)",
+			args: args{
+				s:     "12,34",
+				scale: 0,
+			},
+			want:    DecimalElement{},
+			wantErr: true,
+		},
+		{
+			name: "fail (overflow)",
+			args: args{
+				s:     "99,999,999,999,999,999,999",
+				scale: 0,
+			},
+			want:    DecimalElement{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.args.s, tt.args.scale)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDecimalElement_String(t *testing.T) {
+	type fields struct {
+		decimalElement DecimalElement
+	}
+	tests := []struct {
+		name string
+		fields
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				DecimalElement{
+					Value:  -123450,
+					Scale:  2,
+					IsNull: false,
+				},
+			},
+			want:    "-1234.50",
+			wantErr: false,
+		},
+		{
+			name: "pass (scale 0)",
+			fields: fields{
+				DecimalElement{
+					Value:  9007199254740993,
+					Scale:  0,
+					IsNull: false,
+				},
+			},
+			want:    "9007199254740993",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.decimalElement.String()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDecimalElement_Equal(t *testing.T) {
+	type fields struct {
+		decimalElement DecimalElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want bool
+	}{
+		{
+			name: "pass (different scales)",
+			fields: fields{
+				decimalElement: DecimalElement{
+					Value:  150,
+					Scale:  2,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: DecimalElement{
+					Value:  15,
+					Scale:  1,
+					IsNull: false,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "fail (type mismatch)",
+			fields: fields{
+				decimalElement: DecimalElement{
+					Value:  15,
+					Scale:  1,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: NumericElement{
+					Value:  1.5,
+					IsNull: false,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.decimalElement.Equal(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
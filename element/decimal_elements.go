@@ -0,0 +1,188 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+type DecimalElements []DecimalElement
+
+func NewDecimalElements(decimalElements []DecimalElement) DecimalElements {
+	return decimalElements
+}
+
+// Len returns the length of the elements.
+func (de DecimalElements) Len() int {
+	return len(de)
+}
+
+// GetElement returns the element at the given index.
+func (de DecimalElements) GetElement(index int) (Element, error) {
+	if index < 0 || de.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	return de[index], nil
+}
+
+// AddElement adds the given element to the elements.
+func (de DecimalElements) AddElement(e Element) (Elements, error) {
+	decimalElement, ok := e.(DecimalElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	return append(de, decimalElement), nil
+}
+
+// Floats convert decimal elements into float64 slice. The result may not be exact.
+func (de DecimalElements) Floats() ([]float64, error) {
+	floats := make([]float64, de.Len())
+	for i, decimalElement := range de {
+		floatValue, err := decimalElement.Float()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert to float value")
+		}
+		floats[i] = floatValue
+	}
+	return floats, nil
+}
+
+// GetGroupedElement returns a single element if all the elements are the same.
+func (de DecimalElements) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i, element := range de {
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			err := fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+			return nil, err
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns an empty elements.
+func (de DecimalElements) Delete() Elements {
+	return DecimalElements{}
+}
+
+// Clone returns a copy of the elements which does not share its backing array
+func (de DecimalElements) Clone() Elements {
+	if de == nil {
+		return de
+	}
+	cloned := make(DecimalElements, len(de))
+	copy(cloned, de)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (de DecimalElements) Clip() Elements {
+	return de[:len(de):len(de)]
+}
+
+// Append the given elements to the elements.
+func (de DecimalElements) Append(elements2 Elements) (Elements, error) {
+	decimalElements2, ok := elements2.(DecimalElements)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+	}
+	return append(de, decimalElements2...), nil
+}
+
+// Scale returns the largest scale of the elements. It is 0 for empty elements.
+func (de DecimalElements) Scale() int32 {
+	var scale int32
+	for _, decimalElement := range de {
+		if decimalElement.Scale > scale {
+			scale = decimalElement.Scale
+		}
+	}
+	return scale
+}
+
+// Rescale returns the elements of the scale, NA included.
+// It fails if an element has more fractional digits than the scale, so that no value is rounded.
+func (de DecimalElements) Rescale(scale int32) (DecimalElements, error) {
+	if de == nil {
+		return nil, nil
+	}
+	rescaled := make(DecimalElements, de.Len())
+	for i, decimalElement := range de {
+		r, err := decimalElement.Rescale(scale, RoundDown)
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		if !decimalElement.IsNA() && decimalElement.rat().Cmp(r.rat()) != 0 {
+			return nil, fmt.Errorf("too many fractional digits for scale, index: %d, scale: %d", i, scale)
+		}
+		rescaled[i] = r
+	}
+	return rescaled, nil
+}
+
+// UnmarshalJSON decodes a JSON array into decimal elements of the largest scale of the values,
+// so that NA and values with fewer fractional digits have the same scale as the others
+func (de *DecimalElements) UnmarshalJSON(data []byte) error {
+	var decimalElements []DecimalElement
+	if err := json.Unmarshal(data, &decimalElements); err != nil {
+		return errors.Wrap(err, "failed to unmarshal decimal elements")
+	}
+	rescaled, err := DecimalElements(decimalElements).Rescale(DecimalElements(decimalElements).Scale())
+	if err != nil {
+		return errors.Wrap(err, "")
+	}
+	*de = rescaled
+	return nil
+}
+
+// Sum calculate the exact sum of all elements in the largest scale of them. It fails on overflow.
+func (de DecimalElements) Sum() (DecimalElement, error) {
+	sum, scale, err := de.sum()
+	if err != nil {
+		return DecimalElement{}, errors.Wrap(err, "")
+	}
+	return newDecimalElementFromRat(sum, scale, RoundDown)
+}
+
+// Mean calculate the mean of all elements in the largest scale of them, rounded by the mode
+func (de DecimalElements) Mean(mode RoundingMode) (DecimalElement, error) {
+	if de.Len() == 0 {
+		return DecimalElement{}, errors.New("mean of empty elements")
+	}
+	sum, scale, err := de.sum()
+	if err != nil {
+		return DecimalElement{}, errors.Wrap(err, "")
+	}
+	mean := sum.Quo(sum, new(big.Rat).SetInt64(int64(de.Len())))
+	return newDecimalElementFromRat(mean, scale, mode)
+}
+
+func (de DecimalElements) sum() (*big.Rat, int32, error) {
+	sum := new(big.Rat)
+	var scale int32
+	for i, decimalElement := range de {
+		if decimalElement.IsNA() {
+			return nil, 0, fmt.Errorf("can't sum NA, index: %d", i)
+		}
+		sum.Add(sum, decimalElement.rat())
+		if decimalElement.Scale > scale {
+			scale = decimalElement.Scale
+		}
+	}
+	return sum, scale, nil
+}
+
+// ToNumericElements convert decimal elements to numeric elements. The result may not be exact.
+func (de DecimalElements) ToNumericElements() NumericElements {
+	numericElements := make(NumericElements, de.Len())
+	for i, decimalElement := range de {
+		numericElements[i] = decimalElement.ToNumericElement()
+	}
+	return numericElements
+}
@@ -0,0 +1,289 @@
+package element
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecimalElements_Sum(t *testing.T) {
+	type fields struct {
+		decimalElements DecimalElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want    DecimalElement
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				DecimalElements{
+					DecimalElement{
+						Value:  10,
+						Scale:  2,
+						IsNull: false,
+					},
+					DecimalElement{
+						Value:  2,
+						Scale:  1,
+						IsNull: false,
+					},
+				},
+			},
+			want: DecimalElement{
+				Value:  30,
+				Scale:  2,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (null)",
+			fields: fields{
+				DecimalElements{
+					DecimalElement{
+						IsNull: true,
+					},
+				},
+			},
+			want:    DecimalElement{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.decimalElements.Sum()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDecimalElements_Mean(t *testing.T) {
+	type fields struct {
+		decimalElements DecimalElements
+	}
+	type args struct {
+		mode RoundingMode
+	}
+	// mean is 2.5 yen
+	yen := DecimalElements{
+		DecimalElement{
+			Value:  2,
+			Scale:  0,
+			IsNull: false,
+		},
+		DecimalElement{
+			Value:  3,
+			Scale:  0,
+			IsNull: false,
+		},
+	}
+	// mean is -2.5 yen
+	negativeYen := DecimalElements{
+		DecimalElement{
+			Value:  -2,
+			Scale:  0,
+			IsNull: false,
+		},
+		DecimalElement{
+			Value:  -3,
+			Scale:  0,
+			IsNull: false,
+		},
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    DecimalElement
+		wantErr bool
+	}{
+		{
+			name: "pass (half up)",
+			fields: fields{
+				yen,
+			},
+			args: args{
+				mode: RoundHalfUp,
+			},
+			want: DecimalElement{
+				Value:  3,
+				Scale:  0,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (half up of negative)",
+			fields: fields{
+				negativeYen,
+			},
+			args: args{
+				mode: RoundHalfUp,
+			},
+			want: DecimalElement{
+				Value:  -3,
+				Scale:  0,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (half even)",
+			fields: fields{
+				yen,
+			},
+			args: args{
+				mode: RoundHalfEven,
+			},
+			want: DecimalElement{
+				Value:  2,
+				Scale:  0,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (down)",
+			fields: fields{
+				negativeYen,
+			},
+			args: args{
+				mode: RoundDown,
+			},
+			want: DecimalElement{
+				Value:  -2,
+				Scale:  0,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (unsupported rounding mode)",
+			fields: fields{
+				yen,
+			},
+			args: args{
+				mode: RoundingMode("Ceil"),
+			},
+			want:    DecimalElement{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.decimalElements.Mean(tt.args.mode)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDecimalElements_Rescale(t *testing.T) {
+	type args struct {
+		scale int32
+	}
+	tests := []struct {
+		name            string
+		decimalElements DecimalElements
+		args
+		want    DecimalElements
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			decimalElements: DecimalElements{
+				DecimalElement{
+					Value:  15,
+					Scale:  1,
+					IsNull: false,
+				},
+				DecimalElement{
+					IsNull: true,
+				},
+			},
+			args: args{
+				scale: 2,
+			},
+			want: DecimalElements{
+				DecimalElement{
+					Value:  150,
+					Scale:  2,
+					IsNull: false,
+				},
+				DecimalElement{
+					Scale:  2,
+					IsNull: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (too many fractional digits)",
+			decimalElements: DecimalElements{
+				DecimalElement{
+					Value:  155,
+					Scale:  2,
+					IsNull: false,
+				},
+			},
+			args: args{
+				scale: 1,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.decimalElements.Rescale(tt.args.scale)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestDecimalElements_UnmarshalJSON(t *testing.T) {
+	var got DecimalElements
+	if err := json.Unmarshal([]byte(`["1.50",null,"2"]`), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := DecimalElements{
+		DecimalElement{
+			Value:  150,
+			Scale:  2,
+			IsNull: false,
+		},
+		DecimalElement{
+			Scale:  2,
+			IsNull: true,
+		},
+		DecimalElement{
+			Value:  200,
+			Scale:  2,
+			IsNull: false,
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+}
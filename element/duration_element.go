@@ -0,0 +1,95 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DurationElement holds an elapsed time such as a tenure or an evaluation period
+type DurationElement struct {
+	Value  time.Duration
+	IsNull bool
+}
+
+func NewDurationElement(d time.Duration, isNull bool) DurationElement {
+	return DurationElement{d, isNull}
+}
+
+// Float try to convert duration element to float but always return error
+func (de DurationElement) Float() (float64, error) {
+	return 0, fmt.Errorf("duration element cannot be converted into float, de.Value: %s", de.Value)
+}
+
+// String convert duration element to string like "72h3m0.5s"
+func (de DurationElement) String() (string, error) {
+	if de.IsNA() {
+		return "", nil
+	}
+	return de.Value.String(), nil
+}
+
+// ToElements return elements which contains only one element
+func (de DurationElement) ToElements() Elements {
+	return DurationElements{de}
+}
+
+// Equal compare two elements
+func (de DurationElement) Equal(e Element) bool {
+	// If both elements are NA, they are same no matter what value they have
+	if de.IsNA() && e.IsNA() {
+		return true
+	}
+	return de == e
+}
+
+// IsNA return true if element is NA
+func (de DurationElement) IsNA() bool {
+	return de.IsNull
+}
+
+// Compare compare two duration elements
+func (de DurationElement) Compare(e Element) (int, error) {
+	de2, ok := e.(DurationElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if de.IsNA() || de2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	switch {
+	case de.Value < de2.Value:
+		return -1, nil
+	case de.Value > de2.Value:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// MarshalJSON encodes duration element as a string like "72h3m0.5s", or null if it is NA
+func (de DurationElement) MarshalJSON() ([]byte, error) {
+	if de.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(de.Value.String())
+}
+
+// UnmarshalJSON decodes a string like "72h3m0.5s" into duration element. null is decoded as NA.
+func (de *DurationElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*de = NewDurationElement(0, true)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(err, "failed to unmarshal duration element")
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse duration")
+	}
+	*de = NewDurationElement(d, false)
+	return nil
+}
@@ -0,0 +1,71 @@
+package element
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDurationElement_Compare(t *testing.T) {
+	type fields struct {
+		durationElement DurationElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "pass (greater)",
+			fields: fields{
+				durationElement: DurationElement{
+					Value:  2 * time.Hour,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: DurationElement{
+					Value:  time.Hour,
+					IsNull: false,
+				},
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "fail (type mismatch)",
+			fields: fields{
+				durationElement: DurationElement{
+					Value:  2 * time.Hour,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: NumericElement{
+					Value:  1,
+					IsNull: false,
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.durationElement.Compare(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
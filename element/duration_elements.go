@@ -0,0 +1,84 @@
+package element
+
+import (
+	"fmt"
+)
+
+type DurationElements []DurationElement
+
+func NewDurationElements(durationElements []DurationElement) DurationElements {
+	return durationElements
+}
+
+// Len returns the length of the elements.
+func (de DurationElements) Len() int {
+	return len(de)
+}
+
+// GetElement returns the element at the given index.
+func (de DurationElements) GetElement(index int) (Element, error) {
+	if index < 0 || de.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	return de[index], nil
+}
+
+// AddElement adds the given element to the elements.
+func (de DurationElements) AddElement(e Element) (Elements, error) {
+	durationElement, ok := e.(DurationElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	return append(de, durationElement), nil
+}
+
+// Floats trys to return the elements as a slice of float64 but always returns an error.
+func (de DurationElements) Floats() ([]float64, error) {
+	return nil, fmt.Errorf("duration elements cannot be converted into floats")
+}
+
+// GetGroupedElement returns a single element if all the elements are the same.
+func (de DurationElements) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i, element := range de {
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			err := fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+			return nil, err
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns an empty elements.
+func (de DurationElements) Delete() Elements {
+	return DurationElements{}
+}
+
+// Clone returns a copy of the elements which does not share its backing array
+func (de DurationElements) Clone() Elements {
+	if de == nil {
+		return de
+	}
+	cloned := make(DurationElements, len(de))
+	copy(cloned, de)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (de DurationElements) Clip() Elements {
+	return de[:len(de):len(de)]
+}
+
+// Append the given elements to the elements.
+func (de DurationElements) Append(elements2 Elements) (Elements, error) {
+	durationElements2, ok := elements2.(DurationElements)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+	}
+	return append(de, durationElements2...), nil
+}
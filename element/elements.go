@@ -1,5 +1,9 @@
 package element
 
+import (
+	"github.com/pkg/errors"
+)
+
 type Elements interface {
 	Len() int
 	GetElement(int) (Element, error)
@@ -9,3 +13,63 @@ type Elements interface {
 	Delete() Elements
 	Append(elements Elements) (Elements, error)
 }
+
+// cloner is implemented by elements which copy their storage by themselves.
+// It is optional, so that elements implemented outside this package keep satisfying Elements.
+type cloner interface {
+	Clone() Elements
+}
+
+// clipper is implemented by elements which remove their spare capacity by themselves
+type clipper interface {
+	Clip() Elements
+}
+
+// Clone returns a copy of the elements which does not share their storage.
+// Elements without Clone method are copied element by element into Delete of them.
+func Clone(elements Elements) (Elements, error) {
+	if c, ok := elements.(cloner); ok {
+		return c.Clone(), nil
+	}
+	cloned := elements.Delete()
+	for i := 0; i < elements.Len(); i++ {
+		e, err := elements.GetElement(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get element")
+		}
+		cloned, err = cloned.AddElement(e)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to add element")
+		}
+	}
+	return cloned, nil
+}
+
+// Clip returns the elements without spare capacity, so that adding to them reallocates
+// instead of writing into storage shared with others. Elements without Clip method are cloned.
+func Clip(elements Elements) (Elements, error) {
+	if c, ok := elements.(clipper); ok {
+		return c.Clip(), nil
+	}
+	cloned, err := Clone(elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "")
+	}
+	return cloned, nil
+}
+
+// ToArray returns numeric and int elements as NumericArray and IntArray, which store values contiguously.
+// The result does not share storage with the argument. Other elements are cloned as they are.
+func ToArray(elements Elements) (Elements, error) {
+	switch e := elements.(type) {
+	case NumericElements:
+		return e.ToNumericArray(), nil
+	case IntElements:
+		return e.ToIntArray(), nil
+	}
+	cloned, err := Clone(elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "")
+	}
+	return cloned, nil
+}
@@ -0,0 +1,107 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// plainElements implements Elements without Clone and Clip like elements outside this package
+type plainElements struct {
+	elements NumericElements
+}
+
+func (pe plainElements) Len() int {
+	return pe.elements.Len()
+}
+
+func (pe plainElements) GetElement(index int) (Element, error) {
+	return pe.elements.GetElement(index)
+}
+
+func (pe plainElements) AddElement(e Element) (Elements, error) {
+	elements, err := pe.elements.AddElement(e)
+	if err != nil {
+		return nil, err
+	}
+	return plainElements{elements: elements.(NumericElements)}, nil
+}
+
+func (pe plainElements) Floats() ([]float64, error) {
+	return pe.elements.Floats()
+}
+
+func (pe plainElements) GetGroupedElement() (Element, error) {
+	return pe.elements.GetGroupedElement()
+}
+
+func (pe plainElements) Delete() Elements {
+	return plainElements{}
+}
+
+func (pe plainElements) Append(elements2 Elements) (Elements, error) {
+	elements, err := pe.elements.Append(elements2)
+	if err != nil {
+		return nil, err
+	}
+	return plainElements{elements: elements.(NumericElements)}, nil
+}
+
+func TestClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements Elements
+	}{
+		{
+			name:     "pass (clone method)",
+			elements: NumericElements{{Value: 1}, {IsNull: true}},
+		},
+		{
+			name:     "pass (without clone method)",
+			elements: plainElements{elements: NumericElements{{Value: 1}, {IsNull: true}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Clone(tt.elements)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.elements, cmp.AllowUnexported(plainElements{})); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestClip(t *testing.T) {
+	shared := make(NumericElements, 1, 4)
+	tests := []struct {
+		name     string
+		elements Elements
+	}{
+		{
+			name:     "pass (clip method)",
+			elements: shared,
+		},
+		{
+			name:     "pass (without clip method)",
+			elements: plainElements{elements: shared},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clipped, err := Clip(tt.elements)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := clipped.AddElement(NewNumericElement(1, false)); err != nil {
+				t.Fatal(err)
+			}
+			// adding to the clipped elements must not write into the spare capacity
+			if diff := cmp.Diff(shared[:2], NumericElements{{}, {}}); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
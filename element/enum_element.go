@@ -0,0 +1,89 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// EnumElement holds a code of categories such as grades or departments
+type EnumElement struct {
+	Code       int32
+	Categories Categories
+	IsNull     bool
+}
+
+func NewEnumElement(code int32, categories Categories, isNull bool) EnumElement {
+	return EnumElement{code, categories, isNull}
+}
+
+// Float try to convert enum element to float but always return error
+func (ee EnumElement) Float() (float64, error) {
+	return 0, fmt.Errorf("enum element cannot be converted into float, ee.Code: %d", ee.Code)
+}
+
+// String returns the category value of enum element
+func (ee EnumElement) String() (string, error) {
+	if ee.IsNA() {
+		return "", nil
+	}
+	return ee.Categories.Value(ee.Code)
+}
+
+// ToElements return elements which contains only one element
+func (ee EnumElement) ToElements() Elements {
+	code := naCode
+	if !ee.IsNA() {
+		code = ee.Code
+	}
+	return EnumElements{
+		Categories: ee.Categories,
+		Codes:      []int32{code},
+	}
+}
+
+// Equal compare two elements
+func (ee EnumElement) Equal(e Element) bool {
+	// If both elements are NA, they are same no matter what value they have
+	if ee.IsNA() && e.IsNA() {
+		return true
+	}
+	ee2, ok := e.(EnumElement)
+	if !ok || ee.IsNA() || ee2.IsNA() {
+		return false
+	}
+	return ee.Code == ee2.Code && ee.Categories.Equal(ee2.Categories)
+}
+
+// IsNA return true if element is NA
+func (ee EnumElement) IsNA() bool {
+	return ee.IsNull
+}
+
+// Compare compare two enum elements by the order of categories
+func (ee EnumElement) Compare(e Element) (int, error) {
+	ee2, ok := e.(EnumElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if ee.IsNA() || ee2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	if !ee.Categories.Equal(ee2.Categories) {
+		return 0, errors.New("categories are different")
+	}
+	return int(ee.Code - ee2.Code), nil
+}
+
+// MarshalJSON encodes enum element as a JSON string of its value, or null if it is NA
+func (ee EnumElement) MarshalJSON() ([]byte, error) {
+	if ee.IsNA() {
+		return []byte("null"), nil
+	}
+	value, err := ee.String()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get value")
+	}
+	return json.Marshal(value)
+}
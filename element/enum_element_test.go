@@ -0,0 +1,135 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnumElement_Compare(t *testing.T) {
+	grades := Categories{"S", "A", "B", "C"}
+	type fields struct {
+		enumElement EnumElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "pass (business order)",
+			fields: fields{
+				enumElement: EnumElement{
+					Code:       0,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Code:       2,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			want:    -2,
+			wantErr: false,
+		},
+		{
+			name: "fail (different categories)",
+			fields: fields{
+				enumElement: EnumElement{
+					Code:       0,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Code:       0,
+					Categories: Categories{"sales", "dev"},
+					IsNull:     false,
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.enumElement.Compare(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestEnumElement_Equal(t *testing.T) {
+	grades := Categories{"S", "A", "B", "C"}
+	type fields struct {
+		enumElement EnumElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				enumElement: EnumElement{
+					Code:       1,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Code:       1,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "fail (string element of the same value)",
+			fields: fields{
+				enumElement: EnumElement{
+					Code:       1,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			args: args{
+				element: StringElement{
+					Value:  "A",
+					IsNull: false,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.enumElement.Equal(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
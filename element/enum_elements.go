@@ -0,0 +1,189 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// naCode is the code of NA in EnumElements
+const naCode int32 = -1
+
+// EnumElements holds codes of categories shared by all the elements
+type EnumElements struct {
+	Categories Categories
+	Codes      []int32
+}
+
+// NewEnumElements returns enum elements of the codes. -1 is NA.
+func NewEnumElements(categories Categories, codes []int32) (EnumElements, error) {
+	for _, code := range codes {
+		if code < naCode || int(code) >= len(categories) {
+			return EnumElements{}, fmt.Errorf("code out of range, code: %d", code)
+		}
+	}
+	return EnumElements{
+		Categories: categories,
+		Codes:      codes,
+	}, nil
+}
+
+// Len returns the length of the elements.
+func (ee EnumElements) Len() int {
+	return len(ee.Codes)
+}
+
+// GetElement returns the element at the given index.
+func (ee EnumElements) GetElement(index int) (Element, error) {
+	if index < 0 || ee.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	code := ee.Codes[index]
+	if code == naCode {
+		return NewEnumElement(0, ee.Categories, true), nil
+	}
+	return NewEnumElement(code, ee.Categories, false), nil
+}
+
+// AddElement adds the given element to the elements.
+// An element of other categories is added by its value, which must be in the categories of the elements.
+func (ee EnumElements) AddElement(e Element) (Elements, error) {
+	enumElement, ok := e.(EnumElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	code, err := ee.codeOf(enumElement)
+	if err != nil {
+		return nil, errors.Wrap(err, "")
+	}
+	ee.Codes = append(ee.Codes, code)
+	return ee, nil
+}
+
+func (ee EnumElements) codeOf(enumElement EnumElement) (int32, error) {
+	if enumElement.IsNA() {
+		return naCode, nil
+	}
+	if ee.Categories.Equal(enumElement.Categories) {
+		return enumElement.Code, nil
+	}
+	value, err := enumElement.String()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get value")
+	}
+	code, ok := ee.Categories.Code(value)
+	if !ok {
+		return 0, fmt.Errorf("value is not in categories, value: %s", value)
+	}
+	return code, nil
+}
+
+// Floats trys to return the elements as a slice of float64 but always returns an error.
+func (ee EnumElements) Floats() ([]float64, error) {
+	return nil, fmt.Errorf("enum elements cannot be converted into floats")
+}
+
+// GetGroupedElement returns a single element if all the elements are the same.
+func (ee EnumElements) GetGroupedElement() (Element, error) {
+	if ee.Len() == 0 {
+		return nil, nil
+	}
+	for i, code := range ee.Codes {
+		if code != ee.Codes[0] {
+			err := fmt.Errorf("elements are not grouped, groupedCode: %d, code: %d, index: %d", ee.Codes[0], code, i)
+			return nil, err
+		}
+	}
+	return ee.GetElement(0)
+}
+
+// Delete returns an empty elements keeping the categories.
+func (ee EnumElements) Delete() Elements {
+	return EnumElements{
+		Categories: ee.Categories,
+		Codes:      []int32{},
+	}
+}
+
+// Clone returns a copy of the elements which does not share its codes. Categories are shared as they are never modified.
+func (ee EnumElements) Clone() Elements {
+	if ee.Codes != nil {
+		codes := make([]int32, len(ee.Codes))
+		copy(codes, ee.Codes)
+		ee.Codes = codes
+	}
+	return ee
+}
+
+// Clip removes the spare capacity of the codes without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (ee EnumElements) Clip() Elements {
+	ee.Codes = ee.Codes[:len(ee.Codes):len(ee.Codes)]
+	return ee
+}
+
+// Append the given elements to the elements.
+// Elements of other categories are appended by their values, which must be in the categories of the elements.
+func (ee EnumElements) Append(elements2 Elements) (Elements, error) {
+	enumElements2, ok := elements2.(EnumElements)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+	}
+	if ee.Categories.Equal(enumElements2.Categories) {
+		ee.Codes = append(ee.Codes, enumElements2.Codes...)
+		return ee, nil
+	}
+	var elements Elements = ee
+	for i := 0; i < enumElements2.Len(); i++ {
+		e, err := enumElements2.GetElement(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get element")
+		}
+		elements, err = elements.AddElement(e)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to add element")
+		}
+	}
+	return elements, nil
+}
+
+// ToStringElements convert enum elements to string elements of their values
+func (ee EnumElements) ToStringElements() (StringElements, error) {
+	stringElements := make(StringElements, ee.Len())
+	for i := range ee.Codes {
+		e, err := ee.GetElement(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get element")
+		}
+		value, err := e.String()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value")
+		}
+		stringElements[i] = NewStringElement(value, e.IsNA())
+	}
+	return stringElements, nil
+}
+
+// MarshalJSON encodes enum elements as a JSON array of their values
+func (ee EnumElements) MarshalJSON() ([]byte, error) {
+	stringElements, err := ee.ToStringElements()
+	if err != nil {
+		return nil, errors.Wrap(err, "")
+	}
+	return json.Marshal(stringElements)
+}
+
+// UnmarshalJSON decodes a JSON array of values into codes of the categories already set.
+func (ee *EnumElements) UnmarshalJSON(data []byte) error {
+	var stringElements StringElements
+	if err := json.Unmarshal(data, &stringElements); err != nil {
+		return errors.Wrap(err, "failed to unmarshal enum elements")
+	}
+	enumElements, err := stringElements.ToEnumElements(ee.Categories)
+	if err != nil {
+		return errors.Wrap(err, "")
+	}
+	*ee = enumElements
+	return nil
+}
@@ -0,0 +1,205 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnumElements_AddElement(t *testing.T) {
+	grades := Categories{"S", "A", "B", "C"}
+	type fields struct {
+		enumElements EnumElements
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    Elements
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				EnumElements{
+					Categories: grades,
+					Codes:      []int32{0},
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Code:       2,
+					Categories: grades,
+					IsNull:     false,
+				},
+			},
+			want: EnumElements{
+				Categories: grades,
+				Codes:      []int32{0, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (null)",
+			fields: fields{
+				EnumElements{
+					Categories: grades,
+					Codes:      []int32{0},
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Categories: grades,
+					IsNull:     true,
+				},
+			},
+			want: EnumElements{
+				Categories: grades,
+				Codes:      []int32{0, -1},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (other categories containing the value)",
+			fields: fields{
+				EnumElements{
+					Categories: grades,
+					Codes:      []int32{0},
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Code:       0,
+					Categories: Categories{"C", "D"},
+					IsNull:     false,
+				},
+			},
+			want: EnumElements{
+				Categories: grades,
+				Codes:      []int32{0, 3},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (value out of categories)",
+			fields: fields{
+				EnumElements{
+					Categories: grades,
+					Codes:      []int32{0},
+				},
+			},
+			args: args{
+				element: EnumElement{
+					Code:       1,
+					Categories: Categories{"C", "D"},
+					IsNull:     false,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.enumElements.AddElement(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestEnumElements_GetGroupedElement(t *testing.T) {
+	grades := Categories{"S", "A", "B", "C"}
+	type fields struct {
+		enumElements EnumElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want    Element
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				EnumElements{
+					Categories: grades,
+					Codes:      []int32{1, 1},
+				},
+			},
+			want: EnumElement{
+				Code:       1,
+				Categories: grades,
+				IsNull:     false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (not grouped)",
+			fields: fields{
+				EnumElements{
+					Categories: grades,
+					Codes:      []int32{1, 2},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.enumElements.GetGroupedElement()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestEnumElements_Clone(t *testing.T) {
+	ee := EnumElements{
+		Categories: Categories{"S", "A", "B"},
+		Codes:      []int32{2, -1},
+	}
+	got := ee.Clone()
+	if diff := cmp.Diff(got, Elements(ee)); diff != "" {
+		t.Error(diff)
+	}
+	got.(EnumElements).Codes[0] = 0
+	if diff := cmp.Diff(ee.Codes, []int32{2, -1}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestEnumElements_Clip(t *testing.T) {
+	codes := make([]int32, 1, 4)
+	ee := EnumElements{
+		Categories: Categories{"S", "A", "B"},
+		Codes:      codes,
+	}
+	a, err := ee.Clip().AddElement(NewEnumElement(1, ee.Categories, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ee.Clip().AddElement(NewEnumElement(2, ee.Categories, false)); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(a.(EnumElements).Codes, []int32{0, 1}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(codes[:2], []int32{0, 0}); diff != "" {
+		t.Error(diff)
+	}
+}
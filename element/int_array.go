@@ -0,0 +1,288 @@
+package element
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// IntArray is int elements stored as contiguous int64 values with a validity bitmap.
+// It takes 8 bytes and 1 bit per value, while IntElements takes 16 bytes.
+// Validity is nil when no value is NA. Values at NA are ignored.
+type IntArray struct {
+	Values   []int64
+	Validity Bitmap
+}
+
+// NewIntArray makes int array of the values. A nil validity means that no value is NA.
+func NewIntArray(values []int64, validity Bitmap) (IntArray, error) {
+	if validity != nil && len(validity) < bitmapWords(len(values)) {
+		return IntArray{}, fmt.Errorf("validity is shorter than values, len(validity): %d, len(values): %d", len(validity), len(values))
+	}
+	ia := IntArray{Values: values, Validity: validity}
+	if ia.NACount() == 0 {
+		ia.Validity = nil
+	}
+	return ia, nil
+}
+
+func (ia IntArray) Len() int {
+	return len(ia.Values)
+}
+
+// IsNA reports whether the value at index is NA without boxing it into an element
+func (ia IntArray) IsNA(index int) bool {
+	return !ia.Validity.IsValid(index)
+}
+
+// NACount returns the number of NA values
+func (ia IntArray) NACount() int {
+	if ia.Validity == nil {
+		return 0
+	}
+	count := 0
+	for i := 0; i < ia.Len(); i++ {
+		if ia.IsNA(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// GetElement returns element at index
+func (ia IntArray) GetElement(index int) (Element, error) {
+	if index < 0 || ia.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	if ia.IsNA(index) {
+		return NewIntElement(0, true), nil
+	}
+	return NewIntElement(ia.Values[index], false), nil
+}
+
+// AddElement adds int element to the array.
+// Like append, the result shares its storage with the receiver only while the values have spare capacity,
+// so clip or clone the array to branch from it.
+func (ia IntArray) AddElement(e Element) (Elements, error) {
+	intElement, ok := e.(IntElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	ia.add(intElement)
+	return ia, nil
+}
+
+func (ia *IntArray) add(e IntElement) {
+	index := ia.Len()
+	if index == cap(ia.Values) {
+		// values are reallocated by append, so the bitmap is copied as well like NumericArray
+		ia.Validity = ia.Validity.clone(index)
+	}
+	if e.IsNA() {
+		ia.Values = append(ia.Values, 0)
+		if ia.Validity == nil {
+			ia.Validity = NewBitmap(index + 1)
+		}
+		ia.Validity = ia.Validity.grow(index + 1)
+		ia.Validity.Clear(index)
+		return
+	}
+	ia.Values = append(ia.Values, e.Value)
+	if ia.Validity != nil {
+		ia.Validity = ia.Validity.grow(index + 1)
+		ia.Validity.Set(index)
+	}
+}
+
+// Take returns an array of the values at the indexes without boxing them into elements
+func (ia IntArray) Take(indexes []int) (IntArray, error) {
+	if len(indexes) == 0 {
+		return IntArray{}, nil
+	}
+	taken := IntArray{Values: make([]int64, 0, len(indexes))}
+	for _, index := range indexes {
+		if index < 0 || ia.Len() <= index {
+			return IntArray{}, fmt.Errorf("index out of range, index: %d", index)
+		}
+		taken.add(NewIntElement(ia.Values[index], ia.IsNA(index)))
+	}
+	return taken, nil
+}
+
+// Floats convert the values into float64 slice. It fails if any value is NA.
+func (ia IntArray) Floats() ([]float64, error) {
+	floats := make([]float64, ia.Len())
+	for i, v := range ia.Values {
+		if ia.IsNA(i) {
+			return nil, fmt.Errorf("can't convert NA to float, index: %d", i)
+		}
+		floats[i] = float64(v)
+	}
+	return floats, nil
+}
+
+// Ints returns the values. When no value is NA, the values are returned without copying,
+// so they must not be modified. It fails if any value is NA like IntElements.
+func (ia IntArray) Ints() ([]int64, error) {
+	if ia.Validity == nil {
+		return ia.Values[:ia.Len():ia.Len()], nil
+	}
+	for i := 0; i < ia.Len(); i++ {
+		if ia.IsNA(i) {
+			return nil, fmt.Errorf("can't convert NA to int, index: %d", i)
+		}
+	}
+	ints := make([]int64, ia.Len())
+	copy(ints, ia.Values)
+	return ints, nil
+}
+
+// Sum calculate the exact sum of all values. It fails on NA or overflow.
+func (ia IntArray) Sum() (int64, error) {
+	ints, err := ia.Ints()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to convert to ints")
+	}
+	return sumInts(ints)
+}
+
+// GetGroupedElement returns single element if all elements are same
+func (ia IntArray) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i := 0; i < ia.Len(); i++ {
+		element, err := ia.GetElement(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			return nil, fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns empty IntArray
+func (ia IntArray) Delete() Elements {
+	return IntArray{}
+}
+
+// Clone returns a copy of the array which does not share its values and validity
+func (ia IntArray) Clone() Elements {
+	if ia.Values != nil {
+		values := make([]int64, ia.Len())
+		copy(values, ia.Values)
+		ia.Values = values
+	}
+	ia.Validity = ia.Validity.clone(ia.Len())
+	return ia
+}
+
+// Clip removes the spare capacity of the values and the validity without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (ia IntArray) Clip() Elements {
+	ia.Values = ia.Values[:ia.Len():ia.Len()]
+	if ia.Validity != nil {
+		ia.Validity = ia.Validity[:len(ia.Validity):len(ia.Validity)]
+	}
+	return ia
+}
+
+// Append appends int array or int elements to the array
+func (ia IntArray) Append(elements2 Elements) (Elements, error) {
+	switch e2 := elements2.(type) {
+	case IntArray:
+		if ia.Validity == nil && e2.Validity == nil {
+			ia.Values = append(ia.Values, e2.Values...)
+			return ia, nil
+		}
+		for i := 0; i < e2.Len(); i++ {
+			ia.add(NewIntElement(e2.Values[i], e2.IsNA(i)))
+		}
+		return ia, nil
+	case IntElements:
+		for _, e := range e2 {
+			ia.add(e)
+		}
+		return ia, nil
+	}
+	return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+}
+
+// ToIntElements convert the array into int elements
+func (ia IntArray) ToIntElements() IntElements {
+	intElements := make(IntElements, ia.Len())
+	for i, v := range ia.Values {
+		intElements[i] = NewIntElement(v, ia.IsNA(i))
+	}
+	return intElements
+}
+
+// ToNumericElements convert the array into numeric elements
+func (ia IntArray) ToNumericElements() NumericElements {
+	numericElements := make(NumericElements, ia.Len())
+	for i, v := range ia.Values {
+		numericElements[i] = NewIntElement(v, ia.IsNA(i)).ToNumericElement()
+	}
+	return numericElements
+}
+
+// ToNumericArray convert the array into numeric array. Values above 2^53 lose precision.
+func (ia IntArray) ToNumericArray() NumericArray {
+	values := make([]float64, ia.Len())
+	for i, v := range ia.Values {
+		values[i] = float64(v)
+	}
+	return NumericArray{Values: values, Validity: ia.Validity.clone(ia.Len())}
+}
+
+// ToIntArray convert int elements into int array
+func (ie IntElements) ToIntArray() IntArray {
+	ia := IntArray{Values: make([]int64, 0, ie.Len())}
+	for _, e := range ie {
+		ia.add(e)
+	}
+	return ia
+}
+
+// MarshalJSON encodes int array as a JSON array in which NA is null
+func (ia IntArray) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, v := range ia.Values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if ia.IsNA(i) {
+			buf.WriteString("null")
+			continue
+		}
+		buf.WriteString(strconv.FormatInt(v, 10))
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array of integers and nulls
+func (ia *IntArray) UnmarshalJSON(data []byte) error {
+	var values []*int64
+	if err := json.Unmarshal(data, &values); err != nil {
+		return errors.Wrap(err, "failed to unmarshal int array")
+	}
+	array := IntArray{Values: make([]int64, 0, len(values))}
+	for _, v := range values {
+		if v == nil {
+			array.add(NewIntElement(0, true))
+			continue
+		}
+		array.add(NewIntElement(*v, false))
+	}
+	*ia = array
+	return nil
+}
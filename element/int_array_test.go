@@ -0,0 +1,283 @@
+package element
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIntArray_AddElement(t *testing.T) {
+	type args struct {
+		elements []Element
+	}
+	tests := []struct {
+		name string
+		args
+		want    Elements
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				elements: []Element{
+					NewIntElement(1, false),
+					NewIntElement(0, true),
+					NewIntElement(3, false),
+				},
+			},
+			want: IntArray{
+				Values:   []int64{1, 0, 3},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (invalid element)",
+			args: args{
+				elements: []Element{
+					NewNumericElement(1, false),
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Elements = IntArray{}
+			var err error
+			for _, e := range tt.args.elements {
+				got, err = got.AddElement(e)
+				if err != nil {
+					break
+				}
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestIntArray_Take(t *testing.T) {
+	array := IntArray{
+		Values:   []int64{1, 0, 3},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	tests := []struct {
+		name      string
+		indexes   []int
+		want      IntArray
+		wantError bool
+	}{
+		{
+			name:    "pass",
+			indexes: []int{2, 0},
+			want: IntArray{
+				Values: []int64{3, 1},
+			},
+			wantError: false,
+		},
+		{
+			name:    "pass (NA)",
+			indexes: []int{1, 2},
+			want: IntArray{
+				Values:   []int64{0, 3},
+				Validity: Bitmap{^uint64(1 << 0)},
+			},
+			wantError: false,
+		},
+		{
+			name:      "fail (index out of range)",
+			indexes:   []int{3},
+			want:      IntArray{},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := array.Take(tt.indexes)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantError); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestIntArray_Sum(t *testing.T) {
+	tests := []struct {
+		name      string
+		array     IntArray
+		want      int64
+		wantError bool
+	}{
+		{
+			name: "pass",
+			array: IntArray{
+				Values: []int64{1 << 53, 1},
+			},
+			want:      1<<53 + 1,
+			wantError: false,
+		},
+		{
+			name: "fail (NA)",
+			array: IntArray{
+				Values:   []int64{1, 0},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			want:      0,
+			wantError: true,
+		},
+		{
+			name: "fail (overflow)",
+			array: IntArray{
+				Values: []int64{1<<63 - 1, 1},
+			},
+			want:      0,
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.array.Sum()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantError); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestIntArray_Append(t *testing.T) {
+	type args struct {
+		elements2 Elements
+	}
+	tests := []struct {
+		name     string
+		elements Elements
+		args
+		want    Elements
+		wantErr bool
+	}{
+		{
+			name: "pass (int elements)",
+			elements: IntArray{
+				Values: []int64{1},
+			},
+			args: args{
+				elements2: IntElements{
+					IntElement{
+						IsNull: true,
+					},
+				},
+			},
+			want: IntArray{
+				Values:   []int64{1, 0},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (int array to int elements)",
+			elements: IntElements{
+				IntElement{
+					Value:  1,
+					IsNull: false,
+				},
+			},
+			args: args{
+				elements2: IntArray{
+					Values: []int64{2},
+				},
+			},
+			want: IntArray{
+				Values: []int64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (invalid elements)",
+			elements: IntArray{
+				Values: []int64{1},
+			},
+			args: args{
+				elements2: NumericArray{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.elements.Append(tt.args.elements2)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestIntArray_Convert(t *testing.T) {
+	array := IntArray{
+		Values:   []int64{2, 0},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	intElements := IntElements{
+		IntElement{
+			Value:  2,
+			IsNull: false,
+		},
+		IntElement{
+			IsNull: true,
+		},
+	}
+	if diff := cmp.Diff(array.ToIntElements(), intElements); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(intElements.ToIntArray(), array); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(array.ToNumericElements(), intElements.ToNumericElements()); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(array.ToNumericArray(), NumericArray{Values: []float64{2, 0}, Validity: Bitmap{^uint64(1 << 1)}}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestIntArray_JSON(t *testing.T) {
+	array := IntArray{
+		Values:   []int64{1 << 53, 0},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	data, err := json.Marshal(array)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(data), `[9007199254740992,null]`); diff != "" {
+		t.Error(diff)
+	}
+	var got IntArray
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, array); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,99 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// IntElement holds an exact 64-bit integer such as an ID or an amount of yen
+type IntElement struct {
+	Value  int64
+	IsNull bool
+}
+
+func NewIntElement(i int64, isNull bool) IntElement {
+	return IntElement{i, isNull}
+}
+
+// Float convert int element to float. Values above 2^53 lose precision.
+func (ie IntElement) Float() (float64, error) {
+	if ie.IsNA() {
+		return 0, fmt.Errorf("can't convert NA to float")
+	}
+	return float64(ie.Value), nil
+}
+
+// String convert int element to string
+func (ie IntElement) String() (string, error) {
+	if ie.IsNA() {
+		return "", nil
+	}
+	return strconv.FormatInt(ie.Value, 10), nil
+}
+
+// ToElements return elements which contains only one element
+func (ie IntElement) ToElements() Elements {
+	return IntElements{ie}
+}
+
+// Equal compare two elements
+func (ie IntElement) Equal(e Element) bool {
+	// If both elements are NA, they are same no matter what value they have
+	if ie.IsNA() && e.IsNA() {
+		return true
+	}
+	return ie == e
+}
+
+// IsNA return true if element is NA
+func (ie IntElement) IsNA() bool {
+	return ie.IsNull
+}
+
+// Compare compare two int elements
+func (ie IntElement) Compare(e Element) (int, error) {
+	ie2, ok := e.(IntElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if ie.IsNA() || ie2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	switch {
+	case ie.Value < ie2.Value:
+		return -1, nil
+	case ie.Value > ie2.Value:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// ToNumericElement convert int element to numeric element
+func (ie IntElement) ToNumericElement() NumericElement {
+	return NewNumericElement(float64(ie.Value), ie.IsNull)
+}
+
+// MarshalJSON encodes int element as a JSON number, or null if it is NA
+func (ie IntElement) MarshalJSON() ([]byte, error) {
+	if ie.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ie.Value)
+}
+
+// UnmarshalJSON decodes a JSON integer into int element. null is decoded as NA.
+func (ie *IntElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*ie = NewIntElement(0, true)
+		return nil
+	}
+	var i int64
+	if err := json.Unmarshal(data, &i); err != nil {
+		return errors.Wrap(err, "failed to unmarshal int element")
+	}
+	*ie = NewIntElement(i, false)
+	return nil
+}
@@ -0,0 +1,109 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIntElement_String(t *testing.T) {
+	type fields struct {
+		intElement IntElement
+	}
+	tests := []struct {
+		name string
+		fields
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				IntElement{
+					Value:  9007199254740993,
+					IsNull: false,
+				},
+			},
+			want:    "9007199254740993",
+			wantErr: false,
+		},
+		{
+			name: "pass (null)",
+			fields: fields{
+				IntElement{
+					IsNull: true,
+				},
+			},
+			want:    "",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.intElement.String()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestIntElement_Equal(t *testing.T) {
+	type fields struct {
+		intElement IntElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				intElement: IntElement{
+					Value:  99,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: IntElement{
+					Value:  99,
+					IsNull: false,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "fail (type mismatch)",
+			fields: fields{
+				intElement: IntElement{
+					Value:  99,
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: NumericElement{
+					Value:  99,
+					IsNull: false,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.intElement.Equal(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
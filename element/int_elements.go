@@ -0,0 +1,140 @@
+package element
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+type IntElements []IntElement
+
+func NewIntElements(intElements []IntElement) IntElements {
+	return intElements
+}
+
+// Len returns the length of the elements.
+func (ie IntElements) Len() int {
+	return len(ie)
+}
+
+// GetElement returns the element at the given index.
+func (ie IntElements) GetElement(index int) (Element, error) {
+	if index < 0 || ie.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	return ie[index], nil
+}
+
+// AddElement adds the given element to the elements.
+func (ie IntElements) AddElement(e Element) (Elements, error) {
+	intElement, ok := e.(IntElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	return append(ie, intElement), nil
+}
+
+// Floats convert int elements into float64 slice
+func (ie IntElements) Floats() ([]float64, error) {
+	floats := make([]float64, ie.Len())
+	for i, intElement := range ie {
+		floatValue, err := intElement.Float()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert to float value")
+		}
+		floats[i] = floatValue
+	}
+	return floats, nil
+}
+
+// Ints convert int elements into int64 slice
+func (ie IntElements) Ints() ([]int64, error) {
+	ints := make([]int64, ie.Len())
+	for i, intElement := range ie {
+		if intElement.IsNA() {
+			return nil, fmt.Errorf("can't convert NA to int, index: %d", i)
+		}
+		ints[i] = intElement.Value
+	}
+	return ints, nil
+}
+
+// Sum calculate the exact sum of all elements. It fails on overflow.
+func (ie IntElements) Sum() (int64, error) {
+	ints, err := ie.Ints()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to convert to ints")
+	}
+	return sumInts(ints)
+}
+
+// sumInts sums the integers exactly. It fails on overflow.
+func sumInts(ints []int64) (int64, error) {
+	var sum int64
+	for _, i := range ints {
+		if (i > 0 && sum > math.MaxInt64-i) || (i < 0 && sum < math.MinInt64-i) {
+			return 0, fmt.Errorf("integer overflow, sum: %d, value: %d", sum, i)
+		}
+		sum += i
+	}
+	return sum, nil
+}
+
+// GetGroupedElement returns a single element if all the elements are the same.
+func (ie IntElements) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i, element := range ie {
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			err := fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+			return nil, err
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns an empty elements.
+func (ie IntElements) Delete() Elements {
+	return IntElements{}
+}
+
+// Clone returns a copy of the elements which does not share its backing array
+func (ie IntElements) Clone() Elements {
+	if ie == nil {
+		return ie
+	}
+	cloned := make(IntElements, len(ie))
+	copy(cloned, ie)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (ie IntElements) Clip() Elements {
+	return ie[:len(ie):len(ie)]
+}
+
+// Append appends int elements or int array to IntElements.
+// Appending int array makes int array, so the result does not depend on the order of arguments.
+func (ie IntElements) Append(elements2 Elements) (Elements, error) {
+	switch e2 := elements2.(type) {
+	case IntElements:
+		return append(ie, e2...), nil
+	case IntArray:
+		return ie.ToIntArray().Append(e2)
+	}
+	return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+}
+
+// ToNumericElements convert int elements to numeric elements
+func (ie IntElements) ToNumericElements() NumericElements {
+	numericElements := make(NumericElements, ie.Len())
+	for i, intElement := range ie {
+		numericElements[i] = intElement.ToNumericElement()
+	}
+	return numericElements
+}
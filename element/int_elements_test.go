@@ -0,0 +1,122 @@
+package element
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIntElements_Sum(t *testing.T) {
+	type fields struct {
+		intElements IntElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				IntElements{
+					IntElement{
+						Value:  9007199254740993,
+						IsNull: false,
+					},
+					IntElement{
+						Value:  1,
+						IsNull: false,
+					},
+				},
+			},
+			want:    9007199254740994,
+			wantErr: false,
+		},
+		{
+			name: "fail (overflow)",
+			fields: fields{
+				IntElements{
+					IntElement{
+						Value:  math.MaxInt64,
+						IsNull: false,
+					},
+					IntElement{
+						Value:  1,
+						IsNull: false,
+					},
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "fail (null)",
+			fields: fields{
+				IntElements{
+					IntElement{
+						IsNull: true,
+					},
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.intElements.Sum()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestIntElements_ToNumericElements(t *testing.T) {
+	type fields struct {
+		intElements IntElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want NumericElements
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				IntElements{
+					IntElement{
+						Value:  3,
+						IsNull: false,
+					},
+					IntElement{
+						IsNull: true,
+					},
+				},
+			},
+			want: NumericElements{
+				NumericElement{
+					Value:  3,
+					IsNull: false,
+				},
+				NumericElement{
+					IsNull: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.intElements.ToNumericElements()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package element
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// naKey is the key of NA. NA equals NA no matter what type it is.
+const naKey = "NA"
+
+// Key returns a string which is the same for elements which are Equal, so that elements can be used as map keys.
+// Elements of different types may share a key, so keys should be compared within a column.
+func Key(e Element) string {
+	if e.IsNA() {
+		return naKey
+	}
+	switch v := e.(type) {
+	case StringElement:
+		return "s:" + v.Value
+	case NumericElement:
+		// -0 equals 0
+		if v.Value == 0 {
+			return "f:0"
+		}
+		return "f:" + strconv.FormatFloat(v.Value, 'g', -1, 64)
+	case IntElement:
+		return "i:" + strconv.FormatInt(v.Value, 10)
+	case BoolElement:
+		return "b:" + strconv.FormatBool(v.Value)
+	case TimeElement:
+		// times of the same instant are equal in any location
+		return "t:" + v.Value.UTC().Format(time.RFC3339Nano)
+	case DurationElement:
+		return "d:" + strconv.FormatInt(int64(v.Value), 10)
+	case EnumElement:
+		return "e:" + strconv.FormatInt(int64(v.Code), 10)
+	case DecimalElement:
+		// 1.5 and 1.50 are equal
+		return "m:" + v.rat().RatString()
+	case StringListElement:
+		var b strings.Builder
+		b.WriteString("l:")
+		for _, s := range v {
+			// length prefix keeps ["a,b"] and ["a", "b"] apart
+			b.WriteString(strconv.Itoa(len(s)))
+			b.WriteByte(':')
+			b.WriteString(s)
+		}
+		return b.String()
+	}
+	return fmt.Sprintf("%T:%v", e, e)
+}
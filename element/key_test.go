@@ -0,0 +1,66 @@
+package element
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		e1        Element
+		e2        Element
+		wantEqual bool
+	}{
+		{
+			name:      "pass (NA)",
+			e1:        NewStringElement("", true),
+			e2:        NewNumericElement(0, true),
+			wantEqual: true,
+		},
+		{
+			name:      "pass (negative zero)",
+			e1:        NewNumericElement(0, false),
+			e2:        NewNumericElement(math.Copysign(0, -1), false),
+			wantEqual: true,
+		},
+		{
+			name:      "pass (same instant in different locations)",
+			e1:        NewTimeElement(time.Date(2022, 4, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)), false),
+			e2:        NewTimeElement(time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), false),
+			wantEqual: true,
+		},
+		{
+			name:      "pass (decimals of different scales)",
+			e1:        NewDecimalElement(150, 2, false),
+			e2:        NewDecimalElement(15, 1, false),
+			wantEqual: true,
+		},
+		{
+			name:      "pass (string lists are not ambiguous)",
+			e1:        NewStringListElement([]string{"a,b"}),
+			e2:        NewStringListElement([]string{"a", "b"}),
+			wantEqual: false,
+		},
+		{
+			name:      "pass (NA and empty string)",
+			e1:        NewStringElement("", true),
+			e2:        NewStringElement("", false),
+			wantEqual: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Key(tt.e1) == Key(tt.e2)
+			if diff := cmp.Diff(got, tt.wantEqual); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(got, tt.e1.Equal(tt.e2)); diff != "" {
+				t.Error("key must be consistent with Equal", diff)
+			}
+		})
+	}
+}
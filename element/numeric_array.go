@@ -0,0 +1,305 @@
+package element
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// NumericArray is numeric elements stored as contiguous float64 values with a validity bitmap.
+// It takes 8 bytes and 1 bit per value, while NumericElements takes 16 bytes.
+// Validity is nil when no value is NA. Values at NA are ignored.
+//
+// ReadCSV, UnmarshalElementsJSON, Cast, GroupBy and the aggregation of groups make NumericArray,
+// while elements made by user code stay NumericElements until they are grouped.
+// Both are NumericType and appending one to the other makes NumericArray if either is NumericArray.
+// Integers are stored as IntArray in the same way. Other types still keep one element per value.
+type NumericArray struct {
+	Values   []float64
+	Validity Bitmap
+}
+
+// NewNumericArray makes numeric array of the values. A nil validity means that no value is NA.
+func NewNumericArray(values []float64, validity Bitmap) (NumericArray, error) {
+	if validity != nil && len(validity) < bitmapWords(len(values)) {
+		return NumericArray{}, fmt.Errorf("validity is shorter than values, len(validity): %d, len(values): %d", len(validity), len(values))
+	}
+	na := NumericArray{Values: values, Validity: validity}
+	if na.NACount() == 0 {
+		na.Validity = nil
+	}
+	return na, nil
+}
+
+func (na NumericArray) Len() int {
+	return len(na.Values)
+}
+
+// IsNA reports whether the value at index is NA without boxing it into an element
+func (na NumericArray) IsNA(index int) bool {
+	return !na.Validity.IsValid(index)
+}
+
+// NACount returns the number of NA values
+func (na NumericArray) NACount() int {
+	if na.Validity == nil {
+		return 0
+	}
+	count := 0
+	for i := 0; i < na.Len(); i++ {
+		if na.IsNA(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// GetElement returns element at index
+func (na NumericArray) GetElement(index int) (Element, error) {
+	if index < 0 || na.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	if na.IsNA(index) {
+		return NewNumericElement(0, true), nil
+	}
+	return NewNumericElement(na.Values[index], false), nil
+}
+
+// AddElement adds numeric element to the array.
+// Like append, the result shares its storage with the receiver only while the values have spare capacity,
+// so clip or clone the array to branch from it.
+func (na NumericArray) AddElement(e Element) (Elements, error) {
+	numericElement, ok := e.(NumericElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	na.add(numericElement)
+	return na, nil
+}
+
+func (na *NumericArray) add(e NumericElement) {
+	index := na.Len()
+	if index == cap(na.Values) {
+		// values are reallocated by append, so the bitmap is copied as well.
+		// Otherwise arrays added from the same array would share the words of the bitmap.
+		na.Validity = na.Validity.clone(index)
+	}
+	if e.IsNA() {
+		na.Values = append(na.Values, 0)
+		if na.Validity == nil {
+			// the bitmap is made on the first NA
+			na.Validity = NewBitmap(index + 1)
+		}
+		na.Validity = na.Validity.grow(index + 1)
+		na.Validity.Clear(index)
+		return
+	}
+	na.Values = append(na.Values, e.Value)
+	if na.Validity != nil {
+		na.Validity = na.Validity.grow(index + 1)
+		na.Validity.Set(index)
+	}
+}
+
+// Take returns an array of the values at the indexes without boxing them into elements
+func (na NumericArray) Take(indexes []int) (NumericArray, error) {
+	if len(indexes) == 0 {
+		return NumericArray{}, nil
+	}
+	taken := NumericArray{Values: make([]float64, 0, len(indexes))}
+	for _, index := range indexes {
+		if index < 0 || na.Len() <= index {
+			return NumericArray{}, fmt.Errorf("index out of range, index: %d", index)
+		}
+		taken.add(NewNumericElement(na.Values[index], na.IsNA(index)))
+	}
+	return taken, nil
+}
+
+// Floats returns the values. When no value is NA, the values are returned without copying,
+// so they must not be modified. It fails if any value is NA like NumericElements.
+func (na NumericArray) Floats() ([]float64, error) {
+	if na.Validity == nil {
+		return na.Values[:na.Len():na.Len()], nil
+	}
+	for i := 0; i < na.Len(); i++ {
+		if na.IsNA(i) {
+			return nil, fmt.Errorf("can't convert NA to float, index: %d", i)
+		}
+	}
+	floats := make([]float64, na.Len())
+	copy(floats, na.Values)
+	return floats, nil
+}
+
+// GetGroupedElement returns single element if all elements are same
+func (na NumericArray) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i := 0; i < na.Len(); i++ {
+		element, err := na.GetElement(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			return nil, fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns empty NumericArray
+func (na NumericArray) Delete() Elements {
+	return NumericArray{}
+}
+
+// Clone returns a copy of the array which does not share its values and validity
+func (na NumericArray) Clone() Elements {
+	if na.Values != nil {
+		values := make([]float64, na.Len())
+		copy(values, na.Values)
+		na.Values = values
+	}
+	na.Validity = na.Validity.clone(na.Len())
+	return na
+}
+
+// Clip removes the spare capacity of the values and the validity without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (na NumericArray) Clip() Elements {
+	na.Values = na.Values[:na.Len():na.Len()]
+	if na.Validity != nil {
+		na.Validity = na.Validity[:len(na.Validity):len(na.Validity)]
+	}
+	return na
+}
+
+// Append appends numeric array or numeric elements to the array
+func (na NumericArray) Append(elements2 Elements) (Elements, error) {
+	switch e2 := elements2.(type) {
+	case NumericArray:
+		if na.Validity == nil && e2.Validity == nil {
+			na.Values = append(na.Values, e2.Values...)
+			return na, nil
+		}
+		for i := 0; i < e2.Len(); i++ {
+			na.add(NewNumericElement(e2.Values[i], e2.IsNA(i)))
+		}
+		return na, nil
+	case NumericElements:
+		for _, e := range e2 {
+			na.add(e)
+		}
+		return na, nil
+	}
+	return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+}
+
+// ToNumericElements convert the array into numeric elements
+func (na NumericArray) ToNumericElements() NumericElements {
+	numericElements := make(NumericElements, na.Len())
+	for i, v := range na.Values {
+		if na.IsNA(i) {
+			numericElements[i] = NewNumericElement(0, true)
+			continue
+		}
+		numericElements[i] = NewNumericElement(v, false)
+	}
+	return numericElements
+}
+
+// ToIntElements convert the array into int elements. It fails if any value is not an integer within int64.
+func (na NumericArray) ToIntElements() (IntElements, error) {
+	intElements := make(IntElements, na.Len())
+	for i, v := range na.Values {
+		intElement, err := NewNumericElement(v, na.IsNA(i)).ToIntElement()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert to int element, index: %d", i)
+		}
+		intElements[i] = intElement
+	}
+	return intElements, nil
+}
+
+// ToIntArray convert the array into int array. It fails if any value is not an integer within int64.
+func (na NumericArray) ToIntArray() (IntArray, error) {
+	values := make([]int64, na.Len())
+	for i, v := range na.Values {
+		if na.IsNA(i) {
+			continue
+		}
+		intElement, err := NewNumericElement(v, false).ToIntElement()
+		if err != nil {
+			return IntArray{}, errors.Wrapf(err, "failed to convert to int element, index: %d", i)
+		}
+		values[i] = intElement.Value
+	}
+	return IntArray{Values: values, Validity: na.Validity.clone(na.Len())}, nil
+}
+
+// ToDecimalElements convert the array into decimal elements of the scale rounded by the mode
+func (na NumericArray) ToDecimalElements(scale int32, mode RoundingMode) (DecimalElements, error) {
+	decimalElements := make(DecimalElements, na.Len())
+	for i, v := range na.Values {
+		decimalElement, err := NewNumericElement(v, na.IsNA(i)).ToDecimalElement(scale, mode)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert to decimal element, index: %d", i)
+		}
+		decimalElements[i] = decimalElement
+	}
+	return decimalElements, nil
+}
+
+// ToNumericArray convert numeric elements into numeric array
+func (ne NumericElements) ToNumericArray() NumericArray {
+	na := NumericArray{Values: make([]float64, 0, ne.Len())}
+	for _, e := range ne {
+		na.add(e)
+	}
+	return na
+}
+
+// MarshalJSON encodes numeric array as a JSON array in which NA is null
+func (na NumericArray) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, v := range na.Values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if na.IsNA(i) {
+			buf.WriteString("null")
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal value, index: %d", i)
+		}
+		buf.Write(b)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array of numbers and nulls
+func (na *NumericArray) UnmarshalJSON(data []byte) error {
+	var values []*float64
+	if err := json.Unmarshal(data, &values); err != nil {
+		return errors.Wrap(err, "failed to unmarshal numeric array")
+	}
+	array := NumericArray{Values: make([]float64, 0, len(values))}
+	for _, v := range values {
+		if v == nil {
+			array.add(NewNumericElement(0, true))
+			continue
+		}
+		array.add(NewNumericElement(*v, false))
+	}
+	*na = array
+	return nil
+}
@@ -0,0 +1,464 @@
+package element
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewNumericArray(t *testing.T) {
+	type args struct {
+		values   []float64
+		validity Bitmap
+	}
+	tests := []struct {
+		name string
+		args
+		want    NumericArray
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				values:   []float64{1, 0, 3},
+				validity: Bitmap{^uint64(1 << 1)},
+			},
+			want: NumericArray{
+				Values:   []float64{1, 0, 3},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (validity without NA is dropped)",
+			args: args{
+				values:   []float64{1, 2},
+				validity: NewBitmap(2),
+			},
+			want: NumericArray{
+				Values: []float64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (short validity)",
+			args: args{
+				values:   make([]float64, 65),
+				validity: NewBitmap(1),
+			},
+			want:    NumericArray{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewNumericArray(tt.args.values, tt.args.validity)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestNumericArray_AddElement(t *testing.T) {
+	type args struct {
+		elements []Element
+	}
+	tests := []struct {
+		name string
+		args
+		want    Elements
+		wantErr bool
+	}{
+		{
+			name: "pass (no NA)",
+			args: args{
+				elements: []Element{NewNumericElement(1, false), NewNumericElement(2, false)},
+			},
+			want: NumericArray{
+				Values: []float64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (NA)",
+			args: args{
+				elements: []Element{NewNumericElement(1, false), NewNumericElement(0, true), NewNumericElement(3, false)},
+			},
+			want: NumericArray{
+				Values:   []float64{1, 0, 3},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (invalid element)",
+			args: args{
+				elements: []Element{NewStringElement("1", false)},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Elements = NumericArray{}
+			var err error
+			for _, e := range tt.args.elements {
+				got, err = got.AddElement(e)
+				if err != nil {
+					break
+				}
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestNumericArray_AddElement_AcrossWords(t *testing.T) {
+	var elements Elements = NumericArray{}
+	for i := 0; i < 130; i++ {
+		var err error
+		elements, err = elements.AddElement(NewNumericElement(float64(i), i%65 == 64))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := elements.(NumericArray)
+	if diff := cmp.Diff(got.Validity, Bitmap{^uint64(0), ^uint64(1 << 0), ^uint64(1 << 1)}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(got.NACount(), 2); diff != "" {
+		t.Error(diff)
+	}
+	for _, index := range []int{63, 64, 65, 129} {
+		e, err := got.GetElement(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(e.IsNA(), index == 64 || index == 129); diff != "" {
+			t.Errorf("index: %d, %s", index, diff)
+		}
+	}
+}
+
+func TestNumericArray_AddElement_SharedValidity(t *testing.T) {
+	base := NumericArray{Values: make([]float64, 3, 3), Validity: NewBitmap(3)}
+	a, err := base.AddElement(NewNumericElement(0, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := base.AddElement(NewNumericElement(7, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(a.(NumericArray).IsNA(3), true); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(b.(NumericArray).IsNA(3), false); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(base.Validity, NewBitmap(3)); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestNumericArray_Take(t *testing.T) {
+	array := NumericArray{
+		Values:   []float64{1, 0, 3},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	tests := []struct {
+		name      string
+		indexes   []int
+		want      NumericArray
+		wantError bool
+	}{
+		{
+			name:    "pass",
+			indexes: []int{2, 0},
+			want: NumericArray{
+				Values: []float64{3, 1},
+			},
+			wantError: false,
+		},
+		{
+			name:    "pass (NA)",
+			indexes: []int{1, 2},
+			want: NumericArray{
+				Values:   []float64{0, 3},
+				Validity: Bitmap{^uint64(1 << 0)},
+			},
+			wantError: false,
+		},
+		{
+			name:      "pass (no index)",
+			indexes:   nil,
+			want:      NumericArray{},
+			wantError: false,
+		},
+		{
+			name:      "fail (index out of range)",
+			indexes:   []int{3},
+			want:      NumericArray{},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := array.Take(tt.indexes)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantError); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestNumericArray_Floats(t *testing.T) {
+	tests := []struct {
+		name      string
+		array     NumericArray
+		want      []float64
+		wantError bool
+	}{
+		{
+			name: "pass",
+			array: NumericArray{
+				Values: []float64{3, 3, 6},
+			},
+			want:      []float64{3, 3, 6},
+			wantError: false,
+		},
+		{
+			name: "fail (NA)",
+			array: NumericArray{
+				Values:   []float64{3, 0},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			want:      nil,
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.array.Floats()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantError); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestNumericArray_Floats_ZeroCopy(t *testing.T) {
+	array := NumericArray{Values: make([]float64, 3, 4)}
+	got, err := array.Floats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if &got[0] != &array.Values[0] {
+		t.Error("values are copied")
+	}
+	// appending to the result must not write into the array
+	if diff := cmp.Diff(cap(got), 3); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestNumericArray_Append(t *testing.T) {
+	type args struct {
+		elements2 Elements
+	}
+	tests := []struct {
+		name  string
+		array NumericArray
+		args
+		want    Elements
+		wantErr bool
+	}{
+		{
+			name: "pass (numeric array)",
+			array: NumericArray{
+				Values: []float64{1},
+			},
+			args: args{
+				elements2: NumericArray{
+					Values:   []float64{0, 3},
+					Validity: Bitmap{^uint64(1 << 0)},
+				},
+			},
+			want: NumericArray{
+				Values:   []float64{1, 0, 3},
+				Validity: Bitmap{^uint64(1 << 1)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (numeric elements)",
+			array: NumericArray{
+				Values:   []float64{0},
+				Validity: Bitmap{^uint64(1 << 0)},
+			},
+			args: args{
+				elements2: NumericElements{
+					NumericElement{
+						Value:  2,
+						IsNull: false,
+					},
+				},
+			},
+			want: NumericArray{
+				Values:   []float64{0, 2},
+				Validity: Bitmap{^uint64(1 << 0)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (invalid elements)",
+			array: NumericArray{
+				Values: []float64{1},
+			},
+			args: args{
+				elements2: StringElements{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.array.Append(tt.args.elements2)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestNumericArray_Clone(t *testing.T) {
+	array := NumericArray{
+		Values:   []float64{1, 0},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	got := array.Clone().(NumericArray)
+	if diff := cmp.Diff(got, array); diff != "" {
+		t.Error(diff)
+	}
+	got.Values[0] = 2
+	got.Validity.Set(1)
+	if diff := cmp.Diff(array, NumericArray{Values: []float64{1, 0}, Validity: Bitmap{^uint64(1 << 1)}}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestNumericArray_NumericElements(t *testing.T) {
+	numericElements := NumericElements{
+		NumericElement{
+			Value:  1.5,
+			IsNull: false,
+		},
+		NumericElement{
+			IsNull: true,
+		},
+	}
+	array := numericElements.ToNumericArray()
+	if diff := cmp.Diff(array, NumericArray{Values: []float64{1.5, 0}, Validity: Bitmap{^uint64(1 << 1)}}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(array.ToNumericElements(), numericElements); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestNumericArray_Convert(t *testing.T) {
+	array := NumericArray{
+		Values:   []float64{2, 0},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	intElements, err := array.ToIntElements()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(intElements, IntElements{IntElement{Value: 2}, IntElement{IsNull: true}}); diff != "" {
+		t.Error(diff)
+	}
+	intArray, err := array.ToIntArray()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(intArray, IntArray{Values: []int64{2, 0}, Validity: Bitmap{^uint64(1 << 1)}}); diff != "" {
+		t.Error(diff)
+	}
+	decimalElements, err := array.ToDecimalElements(1, RoundHalfUp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(decimalElements, DecimalElements{DecimalElement{Value: 20, Scale: 1}, DecimalElement{Scale: 1, IsNull: true}}); diff != "" {
+		t.Error(diff)
+	}
+	if _, err := (NumericArray{Values: []float64{1.5}}).ToIntArray(); err == nil {
+		t.Error("non integer must fail")
+	}
+}
+
+func TestNumericArray_JSON(t *testing.T) {
+	array := NumericArray{
+		Values:   []float64{1.5, 0},
+		Validity: Bitmap{^uint64(1 << 1)},
+	}
+	data, err := json.Marshal(array)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(data), `[1.5,null]`); diff != "" {
+		t.Error(diff)
+	}
+	var got NumericArray
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, array); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func benchmarkFloats(b *testing.B, elements Elements) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := elements.Floats(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNumericArray_Floats(b *testing.B) {
+	values := make([]float64, 1000000)
+	b.Run("NumericArray", func(b *testing.B) {
+		benchmarkFloats(b, NumericArray{Values: values})
+	})
+	b.Run("NumericElements", func(b *testing.B) {
+		benchmarkFloats(b, NumericArray{Values: values}.ToNumericElements())
+	})
+}
@@ -1,8 +1,12 @@
 package element
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
+
+	"github.com/pkg/errors"
 )
 
 type NumericElement struct {
@@ -48,3 +52,66 @@ func (ne NumericElement) Equal(e Element) bool {
 func (ne NumericElement) IsNA() bool {
 	return ne.IsNull
 }
+
+// Compare compare two numeric elements
+func (ne NumericElement) Compare(e Element) (int, error) {
+	ne2, ok := e.(NumericElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if ne.IsNA() || ne2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	switch {
+	case ne.Value < ne2.Value:
+		return -1, nil
+	case ne.Value > ne2.Value:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// MarshalJSON encodes numeric element as a JSON number, or null if it is NA
+func (ne NumericElement) MarshalJSON() ([]byte, error) {
+	if ne.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ne.Value)
+}
+
+// UnmarshalJSON decodes a JSON number into numeric element. null is decoded as NA.
+func (ne *NumericElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*ne = NewNumericElement(0, true)
+		return nil
+	}
+	var f float64
+	if err := json.Unmarshal(data, &f); err != nil {
+		return errors.Wrap(err, "failed to unmarshal numeric element")
+	}
+	*ne = NewNumericElement(f, false)
+	return nil
+}
+
+// ToIntElement convert numeric element to int element. It fails if the value is not an integer within int64.
+func (ne NumericElement) ToIntElement() (IntElement, error) {
+	if ne.IsNA() {
+		return NewIntElement(0, true), nil
+	}
+	// float64(math.MaxInt64) is 2^63 which is out of int64
+	if ne.Value != math.Trunc(ne.Value) || ne.Value < math.MinInt64 || ne.Value >= math.MaxInt64 {
+		return IntElement{}, fmt.Errorf("value is not an int64, value: %f", ne.Value)
+	}
+	return NewIntElement(int64(ne.Value), false), nil
+}
+
+// ToDecimalElement convert numeric element to decimal element of the scale rounded by the mode
+func (ne NumericElement) ToDecimalElement(scale int32, mode RoundingMode) (DecimalElement, error) {
+	if ne.IsNA() {
+		return NewDecimalElement(0, scale, true), nil
+	}
+	if math.IsNaN(ne.Value) || math.IsInf(ne.Value, 0) {
+		return DecimalElement{}, fmt.Errorf("value cannot be converted into decimal, value: %f", ne.Value)
+	}
+	return newDecimalElementFromRat(new(big.Rat).SetFloat64(ne.Value), scale, mode)
+}
@@ -1,6 +1,7 @@
 package element
 
 import (
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -129,3 +130,67 @@ func TestNumericElement_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestNumericElement_ToDecimalElement(t *testing.T) {
+	type fields struct {
+		numericElement NumericElement
+	}
+	type args struct {
+		scale int32
+		mode  RoundingMode
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    DecimalElement
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				NumericElement{
+					Value:  0.125,
+					IsNull: false,
+				},
+			},
+			args: args{
+				scale: 2,
+				mode:  RoundHalfEven,
+			},
+			want: DecimalElement{
+				Value:  12,
+				Scale:  2,
+				IsNull: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (NaN)",
+			fields: fields{
+				NumericElement{
+					Value:  math.NaN(),
+					IsNull: false,
+				},
+			},
+			args: args{
+				scale: 2,
+				mode:  RoundHalfEven,
+			},
+			want:    DecimalElement{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.numericElement.ToDecimalElement(tt.args.scale, tt.args.mode)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
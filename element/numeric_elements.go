@@ -71,11 +71,56 @@ func (ne NumericElements) Delete() Elements {
 	return NumericElements{}
 }
 
-// Append appends elements to NumericElements
+// Clone returns a copy of the elements which does not share its backing array
+func (ne NumericElements) Clone() Elements {
+	if ne == nil {
+		return ne
+	}
+	cloned := make(NumericElements, len(ne))
+	copy(cloned, ne)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (ne NumericElements) Clip() Elements {
+	return ne[:len(ne):len(ne)]
+}
+
+// Append appends numeric elements or numeric array to NumericElements.
+// Appending numeric array makes numeric array, so the result does not depend on the order of arguments.
 func (ne NumericElements) Append(elements2 Elements) (Elements, error) {
-	numericElements2, ok := elements2.(NumericElements)
-	if !ok {
-		return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+	switch e2 := elements2.(type) {
+	case NumericElements:
+		return append(ne, e2...), nil
+	case NumericArray:
+		return ne.ToNumericArray().Append(e2)
+	}
+	return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+}
+
+// ToIntElements convert numeric elements to int elements. It fails if any value is not an integer within int64.
+func (ne NumericElements) ToIntElements() (IntElements, error) {
+	intElements := make(IntElements, ne.Len())
+	for i, numericElement := range ne {
+		intElement, err := numericElement.ToIntElement()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert to int element, index: %d", i)
+		}
+		intElements[i] = intElement
+	}
+	return intElements, nil
+}
+
+// ToDecimalElements convert numeric elements to decimal elements of the scale rounded by the mode
+func (ne NumericElements) ToDecimalElements(scale int32, mode RoundingMode) (DecimalElements, error) {
+	decimalElements := make(DecimalElements, ne.Len())
+	for i, numericElement := range ne {
+		decimalElement, err := numericElement.ToDecimalElement(scale, mode)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert to decimal element, index: %d", i)
+		}
+		decimalElements[i] = decimalElement
 	}
-	return append(ne, numericElements2...), nil
+	return decimalElements, nil
 }
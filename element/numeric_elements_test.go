@@ -221,3 +221,65 @@ func TestNumericElements_GetGroupedElement(t *testing.T) {
 
 	}
 }
+
+func TestNumericElements_ToIntElements(t *testing.T) {
+	type fields struct {
+		numericElements NumericElements
+	}
+	tests := []struct {
+		name string
+		fields
+		want    IntElements
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				NumericElements{
+					NumericElement{
+						Value:  3,
+						IsNull: false,
+					},
+					NumericElement{
+						IsNull: true,
+					},
+				},
+			},
+			want: IntElements{
+				IntElement{
+					Value:  3,
+					IsNull: false,
+				},
+				IntElement{
+					IsNull: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (not an integer)",
+			fields: fields{
+				NumericElements{
+					NumericElement{
+						Value:  3.5,
+						IsNull: false,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.numericElements.ToIntElements()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
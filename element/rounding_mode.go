@@ -0,0 +1,45 @@
+package element
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode decides how a decimal is rounded to its scale
+type RoundingMode string
+
+const (
+	// RoundDown rounds toward zero
+	RoundDown = RoundingMode("Down")
+	// RoundHalfUp rounds half away from zero
+	RoundHalfUp = RoundingMode("HalfUp")
+	// RoundHalfEven rounds half to the nearest even digit
+	RoundHalfEven = RoundingMode("HalfEven")
+)
+
+// round rounds the rational number to an integer
+func (mode RoundingMode) round(r *big.Rat) (*big.Int, error) {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient, nil
+	}
+	// compare 2 * |remainder| with denominator to find out which side of the half the number is on
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	c := half.Cmp(r.Denom())
+
+	awayFromZero := false
+	switch mode {
+	case RoundDown:
+	case RoundHalfUp:
+		awayFromZero = c >= 0
+	case RoundHalfEven:
+		awayFromZero = c > 0 || (c == 0 && quotient.Bit(0) == 1)
+	default:
+		return nil, fmt.Errorf("unsupported rounding mode, mode: %s", mode)
+	}
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(r.Sign())))
+	}
+	return quotient, nil
+}
@@ -1,8 +1,12 @@
 package element
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 type StringElement struct {
@@ -46,6 +50,18 @@ func (se StringElement) IsNA() bool {
 	return se.IsNull
 }
 
+// Compare compare two string elements lexicographically
+func (se StringElement) Compare(e Element) (int, error) {
+	se2, ok := e.(StringElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if se.IsNA() || se2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	return strings.Compare(se.Value, se2.Value), nil
+}
+
 // Split string element by separator
 func (se StringElement) Split(separator string, limit int) StringListElement {
 	if limit <= 1 {
@@ -54,3 +70,38 @@ func (se StringElement) Split(separator string, limit int) StringListElement {
 	stringList := strings.SplitN(se.Value, separator, limit)
 	return NewStringListElement(stringList)
 }
+
+// MarshalJSON encodes string element as a JSON string, or null if it is NA
+func (se StringElement) MarshalJSON() ([]byte, error) {
+	if se.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(se.Value)
+}
+
+// UnmarshalJSON decodes a JSON string into string element. null is decoded as NA.
+func (se *StringElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*se = NewStringElement("", true)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(err, "failed to unmarshal string element")
+	}
+	*se = NewStringElement(s, false)
+	return nil
+}
+
+// ParseTime parse string element into time element by the layout.
+// Times without a time zone are interpreted in the location.
+func (se StringElement) ParseTime(layout string, loc *time.Location) (TimeElement, error) {
+	if se.IsNA() {
+		return NewTimeElement(time.Time{}, true), nil
+	}
+	t, err := time.ParseInLocation(layout, se.Value, loc)
+	if err != nil {
+		return TimeElement{}, errors.Wrap(err, "failed to parse time")
+	}
+	return NewTimeElement(t, false), nil
+}
@@ -2,6 +2,9 @@ package element
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 type StringElements []StringElement
@@ -58,6 +61,22 @@ func (se StringElements) Delete() Elements {
 	return StringElements{}
 }
 
+// Clone returns a copy of the elements which does not share its backing array
+func (se StringElements) Clone() Elements {
+	if se == nil {
+		return se
+	}
+	cloned := make(StringElements, len(se))
+	copy(cloned, se)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (se StringElements) Clip() Elements {
+	return se[:len(se):len(se)]
+}
+
 // Append the given elements to the elements.
 func (se StringElements) Append(elements2 Elements) (Elements, error) {
 	stringElements2, ok := elements2.(StringElements)
@@ -75,3 +94,51 @@ func (se StringElements) Split(separator string, limit int) (StringListElements,
 	}
 	return stringListElements, nil
 }
+
+// ParseTime parse string in each element into time by the layout and return a list of time elements.
+func (se StringElements) ParseTime(layout string, loc *time.Location) (TimeElements, error) {
+	timeElements := make(TimeElements, se.Len())
+	for i, stringElement := range se {
+		timeElement, err := stringElement.ParseTime(layout, loc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse time, index: %d", i)
+		}
+		timeElements[i] = timeElement
+	}
+	return timeElements, nil
+}
+
+// ToEnumElements convert string elements to enum elements of the categories.
+// It fails if any value is not in the categories.
+func (se StringElements) ToEnumElements(categories Categories) (EnumElements, error) {
+	codes := make([]int32, se.Len())
+	for i, stringElement := range se {
+		if stringElement.IsNA() {
+			codes[i] = naCode
+			continue
+		}
+		code, ok := categories.Code(stringElement.Value)
+		if !ok {
+			return EnumElements{}, fmt.Errorf("value is not in categories, value: %s, index: %d", stringElement.Value, i)
+		}
+		codes[i] = code
+	}
+	return NewEnumElements(categories, codes)
+}
+
+// ParseDecimal parse string in each element into decimal of the scale and return a list of decimal elements.
+func (se StringElements) ParseDecimal(scale int32) (DecimalElements, error) {
+	decimalElements := make(DecimalElements, se.Len())
+	for i, stringElement := range se {
+		if stringElement.IsNA() {
+			decimalElements[i] = NewDecimalElement(0, scale, true)
+			continue
+		}
+		decimalElement, err := ParseDecimal(stringElement.Value, scale)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse decimal, index: %d", i)
+		}
+		decimalElements[i] = decimalElement
+	}
+	return decimalElements, nil
+}
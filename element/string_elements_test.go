@@ -2,6 +2,7 @@ package element
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -222,3 +223,151 @@ func TestStringElements_GetGroupedElement(t *testing.T) {
 
 	}
 }
+
+func TestStringElements_ParseTime(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	type fields struct {
+		stringElements StringElements
+	}
+	type args struct {
+		layout string
+		loc    *time.Location
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    TimeElements
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				StringElements{
+					StringElement{
+						Value:  "2022-04-01",
+						IsNull: false,
+					},
+					StringElement{
+						IsNull: true,
+					},
+				},
+			},
+			args: args{
+				layout: "2006-01-02",
+				loc:    jst,
+			},
+			want: TimeElements{
+				TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, jst),
+					IsNull: false,
+				},
+				TimeElement{
+					IsNull: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (layout mismatch)",
+			fields: fields{
+				StringElements{
+					StringElement{
+						Value:  "2022/04/01",
+						IsNull: false,
+					},
+				},
+			},
+			args: args{
+				layout: "2006-01-02",
+				loc:    jst,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.stringElements.ParseTime(tt.args.layout, tt.args.loc)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestStringElements_ToEnumElements(t *testing.T) {
+	grades := Categories{"S", "A", "B", "C"}
+	type fields struct {
+		stringElements StringElements
+	}
+	type args struct {
+		categories Categories
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    EnumElements
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				StringElements{
+					StringElement{
+						Value:  "B",
+						IsNull: false,
+					},
+					StringElement{
+						IsNull: true,
+					},
+					StringElement{
+						Value:  "S",
+						IsNull: false,
+					},
+				},
+			},
+			args: args{
+				categories: grades,
+			},
+			want: EnumElements{
+				Categories: grades,
+				Codes:      []int32{2, -1, 0},
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (value out of categories)",
+			fields: fields{
+				StringElements{
+					StringElement{
+						Value:  "D",
+						IsNull: false,
+					},
+				},
+			},
+			args: args{
+				categories: grades,
+			},
+			want:    EnumElements{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.stringElements.ToEnumElements(tt.args.categories)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
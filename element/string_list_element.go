@@ -1,9 +1,12 @@
 package element
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
 type StringListElement []string
@@ -50,3 +53,25 @@ func (sle StringListElement) Join(separator string) StringElement {
 	joinedStr := strings.Join(sle, separator)
 	return NewStringElement(joinedStr, false)
 }
+
+// MarshalJSON encodes string list element as a JSON array, or null if it is NA
+func (sle StringListElement) MarshalJSON() ([]byte, error) {
+	if sle.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal([]string(sle))
+}
+
+// UnmarshalJSON decodes a JSON array of strings into string list element. null is decoded as NA.
+func (sle *StringListElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*sle = StringListElement{}
+		return nil
+	}
+	var stringList []string
+	if err := json.Unmarshal(data, &stringList); err != nil {
+		return errors.Wrap(err, "failed to unmarshal string list element")
+	}
+	*sle = NewStringListElement(stringList)
+	return nil
+}
@@ -54,6 +54,22 @@ func (se StringListElements) Delete() Elements {
 	return StringListElements{}
 }
 
+// Clone returns a copy of the elements which does not share its backing array
+func (se StringListElements) Clone() Elements {
+	if se == nil {
+		return se
+	}
+	cloned := make(StringListElements, len(se))
+	copy(cloned, se)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (se StringListElements) Clip() Elements {
+	return se[:len(se):len(se)]
+}
+
 func (se StringListElements) Append(elements2 Elements) (Elements, error) {
 	StringListElements2, ok := elements2.(StringListElements)
 	if !ok {
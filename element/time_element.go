@@ -0,0 +1,119 @@
+package element
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TimeElement holds a point in time with its location
+type TimeElement struct {
+	Value  time.Time
+	IsNull bool
+}
+
+func NewTimeElement(t time.Time, isNull bool) TimeElement {
+	return TimeElement{t, isNull}
+}
+
+// Float try to convert time element to float but always return error
+func (te TimeElement) Float() (float64, error) {
+	return 0, fmt.Errorf("time element cannot be converted into float, te.Value: %s", te.Value)
+}
+
+// String convert time element to string in RFC3339 format
+func (te TimeElement) String() (string, error) {
+	if te.IsNA() {
+		return "", nil
+	}
+	return te.Value.Format(time.RFC3339Nano), nil
+}
+
+// ToElements return elements which contains only one element
+func (te TimeElement) ToElements() Elements {
+	return TimeElements{te}
+}
+
+// Equal compare two elements. Times in different locations are equal if they are the same instant.
+func (te TimeElement) Equal(e Element) bool {
+	// If both elements are NA, they are same no matter what value they have
+	if te.IsNA() && e.IsNA() {
+		return true
+	}
+	te2, ok := e.(TimeElement)
+	if !ok || te.IsNA() || te2.IsNA() {
+		return false
+	}
+	return te.Value.Equal(te2.Value)
+}
+
+// IsNA return true if element is NA
+func (te TimeElement) IsNA() bool {
+	return te.IsNull
+}
+
+// Compare compare the instants of two time elements
+func (te TimeElement) Compare(e Element) (int, error) {
+	te2, ok := e.(TimeElement)
+	if !ok {
+		return 0, fmt.Errorf("invalid element type e: %v", e)
+	}
+	if te.IsNA() || te2.IsNA() {
+		return 0, errors.New("NA cannot be compared")
+	}
+	switch {
+	case te.Value.Before(te2.Value):
+		return -1, nil
+	case te.Value.After(te2.Value):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Format convert time element to string element by the layout
+func (te TimeElement) Format(layout string) StringElement {
+	if te.IsNA() {
+		return NewStringElement("", true)
+	}
+	return NewStringElement(te.Value.Format(layout), false)
+}
+
+// In returns time element in the location
+func (te TimeElement) In(loc *time.Location) TimeElement {
+	if te.IsNA() {
+		return te
+	}
+	return NewTimeElement(te.Value.In(loc), false)
+}
+
+// Sub returns the duration te - te2. It is NA if either is NA.
+func (te TimeElement) Sub(te2 TimeElement) DurationElement {
+	if te.IsNA() || te2.IsNA() {
+		return NewDurationElement(0, true)
+	}
+	return NewDurationElement(te.Value.Sub(te2.Value), false)
+}
+
+// MarshalJSON encodes time element as a RFC3339 string, or null if it is NA
+func (te TimeElement) MarshalJSON() ([]byte, error) {
+	if te.IsNA() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(te.Value)
+}
+
+// UnmarshalJSON decodes a RFC3339 string into time element. null is decoded as NA.
+func (te *TimeElement) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*te = NewTimeElement(time.Time{}, true)
+		return nil
+	}
+	var t time.Time
+	if err := json.Unmarshal(data, &t); err != nil {
+		return errors.Wrap(err, "failed to unmarshal time element")
+	}
+	*te = NewTimeElement(t, false)
+	return nil
+}
@@ -0,0 +1,218 @@
+package element
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTimeElement_Equal(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	type fields struct {
+		timeElement TimeElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want bool
+	}{
+		{
+			name: "pass (same instant in different locations)",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2022, 4, 1, 9, 0, 0, 0, jst),
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "pass (both elements are null)",
+			fields: fields{
+				timeElement: TimeElement{
+					IsNull: true,
+				},
+			},
+			args: args{
+				element: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: true,
+				},
+			},
+			want: true,
+		},
+		{
+			name: "fail (different instant)",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, jst),
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			want: false,
+		},
+		{
+			name: "fail (type mismatch)",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, jst),
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: StringElement{
+					Value:  "2022-04-01",
+					IsNull: false,
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.timeElement.Equal(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTimeElement_Compare(t *testing.T) {
+	type fields struct {
+		timeElement TimeElement
+	}
+	type args struct {
+		element Element
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "pass (before)",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			want:    -1,
+			wantErr: false,
+		},
+		{
+			name: "fail (null)",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			args: args{
+				element: TimeElement{
+					IsNull: true,
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fields.timeElement.Compare(tt.args.element)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestTimeElement_Sub(t *testing.T) {
+	type fields struct {
+		timeElement TimeElement
+	}
+	type args struct {
+		timeElement TimeElement
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want DurationElement
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2022, 4, 2, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			args: args{
+				timeElement: TimeElement{
+					Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			want: DurationElement{
+				Value:  24 * time.Hour,
+				IsNull: false,
+			},
+		},
+		{
+			name: "pass (null)",
+			fields: fields{
+				timeElement: TimeElement{
+					Value:  time.Date(2022, 4, 2, 0, 0, 0, 0, time.UTC),
+					IsNull: false,
+				},
+			},
+			args: args{
+				timeElement: TimeElement{
+					IsNull: true,
+				},
+			},
+			want: DurationElement{
+				IsNull: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.timeElement.Sub(tt.args.timeElement)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
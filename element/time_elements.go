@@ -0,0 +1,103 @@
+package element
+
+import (
+	"fmt"
+	"time"
+)
+
+type TimeElements []TimeElement
+
+func NewTimeElements(timeElements []TimeElement) TimeElements {
+	return timeElements
+}
+
+// Len returns the length of the elements.
+func (te TimeElements) Len() int {
+	return len(te)
+}
+
+// GetElement returns the element at the given index.
+func (te TimeElements) GetElement(index int) (Element, error) {
+	if index < 0 || te.Len() <= index {
+		return nil, fmt.Errorf("index out of range, index: %d", index)
+	}
+	return te[index], nil
+}
+
+// AddElement adds the given element to the elements.
+func (te TimeElements) AddElement(e Element) (Elements, error) {
+	timeElement, ok := e.(TimeElement)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type e: %v", e)
+	}
+	return append(te, timeElement), nil
+}
+
+// Floats trys to return the elements as a slice of float64 but always returns an error.
+func (te TimeElements) Floats() ([]float64, error) {
+	return nil, fmt.Errorf("time elements cannot be converted into floats")
+}
+
+// GetGroupedElement returns a single element if all the elements are the same.
+func (te TimeElements) GetGroupedElement() (Element, error) {
+	var groupedElement Element
+	for i, element := range te {
+		if i == 0 {
+			groupedElement = element
+			continue
+		}
+		if !groupedElement.Equal(element) {
+			err := fmt.Errorf("elements are not grouped, groupedElement: %v, element: %v", groupedElement, element)
+			return nil, err
+		}
+	}
+	return groupedElement, nil
+}
+
+// Delete returns an empty elements.
+func (te TimeElements) Delete() Elements {
+	return TimeElements{}
+}
+
+// Clone returns a copy of the elements which does not share its backing array
+func (te TimeElements) Clone() Elements {
+	if te == nil {
+		return te
+	}
+	cloned := make(TimeElements, len(te))
+	copy(cloned, te)
+	return cloned
+}
+
+// Clip removes the spare capacity of the elements without copying them,
+// so adding to the result reallocates instead of writing into storage shared with others
+func (te TimeElements) Clip() Elements {
+	return te[:len(te):len(te)]
+}
+
+// Append the given elements to the elements.
+func (te TimeElements) Append(elements2 Elements) (Elements, error) {
+	timeElements2, ok := elements2.(TimeElements)
+	if !ok {
+		return nil, fmt.Errorf("invalid element type elements2: %v", elements2)
+	}
+	return append(te, timeElements2...), nil
+}
+
+// Format convert time elements to string elements by the layout
+func (te TimeElements) Format(layout string) StringElements {
+	stringElements := make(StringElements, te.Len())
+	for i, timeElement := range te {
+		stringElements[i] = timeElement.Format(layout)
+	}
+	return stringElements
+}
+
+// In returns time elements in the location
+func (te TimeElements) In(loc *time.Location) TimeElements {
+	timeElements := make(TimeElements, te.Len())
+	for i, timeElement := range te {
+		timeElements[i] = timeElement.In(loc)
+	}
+	return timeElements
+}
@@ -0,0 +1,59 @@
+package element
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTimeElements_Format(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	type fields struct {
+		timeElements TimeElements
+	}
+	type args struct {
+		layout string
+	}
+	tests := []struct {
+		name string
+		fields
+		args
+		want StringElements
+	}{
+		{
+			name: "pass",
+			fields: fields{
+				TimeElements{
+					TimeElement{
+						Value:  time.Date(2022, 4, 1, 9, 30, 0, 0, jst),
+						IsNull: false,
+					},
+					TimeElement{
+						IsNull: true,
+					},
+				},
+			},
+			args: args{
+				layout: "2006/01/02 15:04 MST",
+			},
+			want: StringElements{
+				StringElement{
+					Value:  "2022/04/01 09:30 JST",
+					IsNull: false,
+				},
+				StringElement{
+					IsNull: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fields.timeElements.Format(tt.args.layout)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package series
+
+import "github.com/hrbrain/goban/element"
+
+// NAPolicy decides how aggregation treats NA elements
+type NAPolicy string
+
+const (
+	// SkipNA excludes NA elements from aggregation. It is the default.
+	SkipNA = NAPolicy("")
+	// PropagateNA makes the result NA if the series has any NA element
+	PropagateNA = NAPolicy("Propagate")
+)
+
+type aggregateConfig struct {
+	naPolicy     NAPolicy
+	separator    string
+	distinct     bool
+	sorted       bool
+	roundingMode element.RoundingMode
+}
+
+// AggregateOption configures Series.Aggregate
+type AggregateOption func(*aggregateConfig)
+
+// WithNAPolicy sets how NA elements are treated. The default is SkipNA.
+func WithNAPolicy(policy NAPolicy) AggregateOption {
+	return func(c *aggregateConfig) {
+		c.naPolicy = policy
+	}
+}
+
+// WithConcatSeparator sets the separator of Concat. The default is ",".
+func WithConcatSeparator(separator string) AggregateOption {
+	return func(c *aggregateConfig) {
+		c.separator = separator
+	}
+}
+
+// Distinct makes Concat and Collect drop duplicated strings keeping the first appearance
+func Distinct() AggregateOption {
+	return func(c *aggregateConfig) {
+		c.distinct = true
+	}
+}
+
+// Sorted makes Concat and Collect sort strings in ascending order
+func Sorted() AggregateOption {
+	return func(c *aggregateConfig) {
+		c.sorted = true
+	}
+}
+
+// WithMeanRoundingMode sets the rounding mode of Mean of decimals. The default is DecimalRoundingMode.
+func WithMeanRoundingMode(mode element.RoundingMode) AggregateOption {
+	return func(c *aggregateConfig) {
+		c.roundingMode = mode
+	}
+}
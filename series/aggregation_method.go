@@ -1,10 +1,72 @@
 package series
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type AggregationMethod string
 
 const (
-	Mean  = AggregationMethod("Mean")
+	Mean = AggregationMethod("Mean")
+	// Count is the number of non NA elements
 	Count = AggregationMethod("Count")
-	Sum   = AggregationMethod("Sum")
-	None  = AggregationMethod("")
+	// Size is the number of elements including NA
+	Size   = AggregationMethod("Size")
+	Sum    = AggregationMethod("Sum")
+	Min    = AggregationMethod("Min")
+	Max    = AggregationMethod("Max")
+	Median = AggregationMethod("Median")
+	// Std is the sample standard deviation
+	Std = AggregationMethod("Std")
+	// Var is the sample variance
+	Var = AggregationMethod("Var")
+	// First is the first non NA element
+	First = AggregationMethod("First")
+	// Last is the last non NA element
+	Last = AggregationMethod("Last")
+	// Mode is the most frequent element. Ties are broken by the element which appears first.
+	Mode = AggregationMethod("Mode")
+	// CountDistinct is the number of distinct non NA elements
+	CountDistinct = AggregationMethod("CountDistinct")
+	// Concat joins strings into a string
+	Concat = AggregationMethod("Concat")
+	// Collect gathers strings into a string list
+	Collect = AggregationMethod("Collect")
+	None    = AggregationMethod("")
+
+	quantile = AggregationMethod("Quantile")
 )
+
+// Quantile returns the method which calculates the p-quantile like Quantile(0.25).
+// Values between two elements are linearly interpolated.
+func Quantile(p float64) AggregationMethod {
+	return AggregationMethod(fmt.Sprintf("%s(%s)", quantile, strconv.FormatFloat(p, 'g', -1, 64)))
+}
+
+// QuantileLevel returns p of the method made by Quantile
+func (m AggregationMethod) QuantileLevel() (float64, error) {
+	s := string(m)
+	prefix := string(quantile) + "("
+	if !strings.HasPrefix(s, prefix) || !strings.HasSuffix(s, ")") {
+		return 0, fmt.Errorf("method is not quantile, method: %s", m)
+	}
+	p, err := strconv.ParseFloat(s[len(prefix):len(s)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantile, method: %s", m)
+	}
+	// written so that NaN is rejected as well
+	if !(0 <= p && p <= 1) {
+		return 0, fmt.Errorf("quantile must be between 0 and 1, method: %s", m)
+	}
+	return p, nil
+}
+
+// kind returns the method without its parameter, so that every Quantile(p) is treated alike
+func (m AggregationMethod) kind() AggregationMethod {
+	if strings.HasPrefix(string(m), string(quantile)+"(") {
+		return quantile
+	}
+	return m
+}
@@ -0,0 +1,128 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+)
+
+func TestAggregationMethod_QuantileLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  AggregationMethod
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "pass",
+			method:  Quantile(0.25),
+			want:    0.25,
+			wantErr: false,
+		},
+		{
+			name:    "fail (not quantile)",
+			method:  Median,
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "fail (out of range)",
+			method:  AggregationMethod("Quantile(-0.1)"),
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "fail (NaN)",
+			method:  Quantile(math.NaN()),
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.method.QuantileLevel()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestSeries_Quantile(t *testing.T) {
+	s := Series{
+		Name: "test",
+		Elements: element.NumericElements{
+			element.NumericElement{
+				Value:  1,
+				IsNull: false,
+			},
+			element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			element.NumericElement{
+				Value:  3,
+				IsNull: false,
+			},
+			element.NumericElement{
+				Value:  4,
+				IsNull: false,
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		p       float64
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "pass",
+			p:       0.5,
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name:    "pass (interpolated)",
+			p:       0.625,
+			want:    2.5,
+			wantErr: false,
+		},
+		{
+			name:    "pass (maximum)",
+			p:       1,
+			want:    4,
+			wantErr: false,
+		},
+		{
+			name:    "fail (out of range)",
+			p:       1.5,
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "fail (NaN)",
+			p:       math.NaN(),
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Quantile(tt.p)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
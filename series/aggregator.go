@@ -0,0 +1,94 @@
+package series
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/pkg/errors"
+)
+
+// Aggregator is a user defined aggregation method
+type Aggregator struct {
+	// Aggregate aggregates the series into an element.
+	// NA elements are dropped beforehand by SkipNA, and kept by PropagateNA.
+	// With SkipNA, it is not called when no element is left, and the result is NA instead.
+	Aggregate func(s Series) (element.Element, error)
+	// CanAggregate returns an error if the series cannot be aggregated. nil accepts any series.
+	CanAggregate func(s Series) error
+	// NA is the result when no element is left to aggregate. It must have the type which Aggregate returns,
+	// so that groups without elements make the same column as the others.
+	// nil means NA of the type of the series like built-in methods.
+	NA element.Element
+}
+
+var (
+	aggregatorsMu sync.RWMutex
+	aggregators   = map[AggregationMethod]Aggregator{}
+)
+
+var builtinMethods = map[AggregationMethod]bool{
+	Mean: true, Count: true, Size: true, Sum: true, Min: true, Max: true,
+	Median: true, Std: true, Var: true, First: true, Last: true, Mode: true,
+	CountDistinct: true, Concat: true, Collect: true, None: true, quantile: true,
+}
+
+// RegisterAggregator registers the aggregator as the method, so that it can be used like built-in methods.
+// It fails if the method is built-in or already registered.
+func RegisterAggregator(method AggregationMethod, aggregator Aggregator) error {
+	if builtinMethods[method.kind()] {
+		return fmt.Errorf("built-in method cannot be registered, method: %s", method)
+	}
+	if aggregator.Aggregate == nil {
+		return fmt.Errorf("aggregate function is required, method: %s", method)
+	}
+	if aggregator.NA != nil && !aggregator.NA.IsNA() {
+		return fmt.Errorf("NA element must be NA, method: %s, NA: %v", method, aggregator.NA)
+	}
+	aggregatorsMu.Lock()
+	defer aggregatorsMu.Unlock()
+	if _, ok := aggregators[method]; ok {
+		return fmt.Errorf("method is already registered, method: %s", method)
+	}
+	aggregators[method] = aggregator
+	return nil
+}
+
+// UnregisterAggregator removes the aggregator registered as the method
+func UnregisterAggregator(method AggregationMethod) {
+	aggregatorsMu.Lock()
+	defer aggregatorsMu.Unlock()
+	delete(aggregators, method)
+}
+
+func lookupAggregator(method AggregationMethod) (Aggregator, bool) {
+	aggregatorsMu.RLock()
+	defer aggregatorsMu.RUnlock()
+	aggregator, ok := aggregators[method]
+	return aggregator, ok
+}
+
+// aggregateWith aggregates the series by the user defined aggregator
+func (s Series) aggregateWith(aggregator Aggregator, config aggregateConfig) (element.Element, error) {
+	if config.naPolicy == SkipNA {
+		withoutNA, err := s.DropNA()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to drop NA")
+		}
+		if withoutNA.Len() == 0 {
+			if aggregator.NA != nil {
+				return aggregator.NA, nil
+			}
+			return s.NAElement(), nil
+		}
+		s = withoutNA
+	}
+	aggregated, err := aggregator.Aggregate(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate with user defined aggregator")
+	}
+	if aggregated == nil {
+		return nil, errors.New("user defined aggregator returned nil")
+	}
+	return aggregated, nil
+}
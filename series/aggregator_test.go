@@ -0,0 +1,191 @@
+package series
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+)
+
+// topBox is the ratio of scores of 4 or more
+var topBox = Aggregator{
+	Aggregate: func(s Series) (element.Element, error) {
+		scores, err := s.Floats()
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, score := range scores {
+			if score >= 4 {
+				count++
+			}
+		}
+		return element.NewNumericElement(float64(count)/float64(len(scores)), false), nil
+	},
+	CanAggregate: func(s Series) error {
+		if s.GetType() != NumericType {
+			return errors.New("only numeric is supported")
+		}
+		return nil
+	},
+}
+
+func TestRegisterAggregator(t *testing.T) {
+	type args struct {
+		method     AggregationMethod
+		aggregator Aggregator
+	}
+	tests := []struct {
+		name string
+		args
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				method:     AggregationMethod("TopBox"),
+				aggregator: topBox,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (built-in method)",
+			args: args{
+				method:     Quantile(0.5),
+				aggregator: topBox,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail (NA element is not NA)",
+			args: args{
+				method: AggregationMethod("TopBox"),
+				aggregator: Aggregator{
+					Aggregate: topBox.Aggregate,
+					NA:        element.NewNumericElement(0, false),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail (nil aggregate function)",
+			args: args{
+				method:     AggregationMethod("Nothing"),
+				aggregator: Aggregator{},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterAggregator(tt.args.method, tt.args.aggregator)
+			defer UnregisterAggregator(tt.args.method)
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestRegisterAggregator_Duplicated(t *testing.T) {
+	method := AggregationMethod("TopBox")
+	if err := RegisterAggregator(method, topBox); err != nil {
+		t.Fatal(err)
+	}
+	defer UnregisterAggregator(method)
+	if err := RegisterAggregator(method, topBox); err == nil {
+		t.Error("duplicated registration must fail")
+	}
+}
+
+func TestSeries_Aggregate_UserDefined(t *testing.T) {
+	method := AggregationMethod("TopBox")
+	if err := RegisterAggregator(method, topBox); err != nil {
+		t.Fatal(err)
+	}
+	defer UnregisterAggregator(method)
+
+	type field struct {
+		Series
+	}
+	tests := []struct {
+		name string
+		field
+		want      element.Element
+		wantError bool
+	}{
+		{
+			name: "pass (NA is skipped)",
+			field: field{
+				Series{
+					Name: "score",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  5,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  2,
+							IsNull: false,
+						},
+						element.NumericElement{
+							IsNull: true,
+						},
+					},
+				},
+			},
+			want: element.NumericElement{
+				Value:  0.5,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (all NA)",
+			field: field{
+				Series{
+					Name: "score",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							IsNull: true,
+						},
+					},
+				},
+			},
+			want: element.NumericElement{
+				IsNull: true,
+			},
+			wantError: false,
+		},
+		{
+			name: "fail (rejected type)",
+			field: field{
+				Series{
+					Name: "score",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "5",
+							IsNull: false,
+						},
+					},
+				},
+			},
+			want:      nil,
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.Aggregate(method)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantError); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
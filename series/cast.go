@@ -0,0 +1,376 @@
+package series
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/pkg/errors"
+)
+
+type castConfig struct {
+	lenient      bool
+	separator    string
+	layout       string
+	location     *time.Location
+	scale        int32
+	hasScale     bool
+	roundingMode element.RoundingMode
+	categories   element.Categories
+}
+
+// CastOption configures Series.Cast
+type CastOption func(*castConfig)
+
+// Lenient turns values which cannot be cast into NA instead of failing
+func Lenient() CastOption {
+	return func(c *castConfig) {
+		c.lenient = true
+	}
+}
+
+// WithSeparator sets the separator between strings and string lists. The default is ",".
+func WithSeparator(separator string) CastOption {
+	return func(c *castConfig) {
+		c.separator = separator
+	}
+}
+
+// WithTimeLayout sets the layout between strings and datetimes. The default is time.RFC3339.
+func WithTimeLayout(layout string) CastOption {
+	return func(c *castConfig) {
+		c.layout = layout
+	}
+}
+
+// WithLocation sets the location of datetimes parsed from strings. The default is UTC.
+func WithLocation(loc *time.Location) CastOption {
+	return func(c *castConfig) {
+		c.location = loc
+	}
+}
+
+// WithScale sets the scale of decimals. It is required to cast other types into decimal.
+func WithScale(scale int32) CastOption {
+	return func(c *castConfig) {
+		c.scale = scale
+		c.hasScale = true
+	}
+}
+
+// WithRoundingMode sets the rounding mode of decimals. The default is DecimalRoundingMode.
+func WithRoundingMode(mode element.RoundingMode) CastOption {
+	return func(c *castConfig) {
+		c.roundingMode = mode
+	}
+}
+
+// WithCategories sets the categories of enums. It is required to cast other types into enum.
+func WithCategories(categories element.Categories) CastOption {
+	return func(c *castConfig) {
+		c.categories = categories
+	}
+}
+
+// Cast converts the series into the type.
+// It fails on the first value which cannot be cast unless Lenient is given,
+// in which case the value becomes NA and the number of such values is returned.
+// NA stays NA in any type.
+func (s Series) Cast(t Type, options ...CastOption) (Series, int, error) {
+	config := castConfig{
+		separator:    ",",
+		layout:       time.RFC3339,
+		location:     time.UTC,
+		roundingMode: DecimalRoundingMode,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	if t == s.GetType() && !config.changesSchema(s.Elements) {
+		return s, 0, nil
+	}
+
+	elements, err := config.emptyElements(t)
+	if err != nil {
+		return Series{}, 0, errors.Wrap(err, "")
+	}
+	na, err := config.na(t)
+	if err != nil {
+		return Series{}, 0, errors.Wrap(err, "")
+	}
+	coercedCount := 0
+	for i := 0; i < s.Len(); i++ {
+		e, err := s.GetElement(i)
+		if err != nil {
+			return Series{}, 0, errors.Wrap(err, "failed to get element")
+		}
+		casted := na
+		if !e.IsNA() {
+			casted, err = config.cast(e, t)
+			if err != nil {
+				if !config.lenient {
+					return Series{}, 0, errors.Wrapf(err, "failed to cast element, index: %d", i)
+				}
+				casted = na
+				coercedCount++
+			}
+		}
+		elements, err = elements.AddElement(casted)
+		if err != nil {
+			return Series{}, 0, errors.Wrap(err, "failed to add element")
+		}
+	}
+	newSeries, err := s.UpdateElements(elements)
+	if err != nil {
+		return Series{}, 0, errors.Wrap(err, "")
+	}
+	return newSeries, coercedCount, nil
+}
+
+// changesSchema reports whether casting the elements into their own type changes the scale or the categories
+func (c castConfig) changesSchema(elements element.Elements) bool {
+	switch e := elements.(type) {
+	case element.DecimalElements:
+		if !c.hasScale {
+			return false
+		}
+		for _, de := range e {
+			if !de.IsNA() && de.Scale != c.scale {
+				return true
+			}
+		}
+	case element.EnumElements:
+		return c.categories != nil && !c.categories.Equal(e.Categories)
+	}
+	return false
+}
+
+func (c castConfig) emptyElements(t Type) (element.Elements, error) {
+	switch t {
+	case StringType:
+		return element.StringElements{}, nil
+	case NumericType:
+		return element.NumericArray{}, nil
+	case StringListType:
+		return element.StringListElements{}, nil
+	case BoolType:
+		return element.BoolElements{}, nil
+	case IntegerType:
+		return element.IntArray{}, nil
+	case DatetimeType:
+		return element.TimeElements{}, nil
+	case DurationType:
+		return element.DurationElements{}, nil
+	case DecimalType:
+		if !c.hasScale {
+			return nil, errors.New("scale is required to cast into decimal")
+		}
+		return element.DecimalElements{}, nil
+	case EnumType:
+		if c.categories == nil {
+			return nil, errors.New("categories are required to cast into enum")
+		}
+		return element.EnumElements{Categories: c.categories}, nil
+	}
+	return nil, fmt.Errorf("unsupported type, type: %s", t)
+}
+
+func (c castConfig) na(t Type) (element.Element, error) {
+	switch t {
+	case StringType:
+		return element.NewStringElement("", true), nil
+	case NumericType:
+		return element.NewNumericElement(0, true), nil
+	case StringListType:
+		return element.NewStringListElement(nil), nil
+	case BoolType:
+		return element.NewBoolElement(false, true), nil
+	case IntegerType:
+		return element.NewIntElement(0, true), nil
+	case DatetimeType:
+		return element.NewTimeElement(time.Time{}, true), nil
+	case DurationType:
+		return element.NewDurationElement(0, true), nil
+	case DecimalType:
+		return element.NewDecimalElement(0, c.scale, true), nil
+	case EnumType:
+		return element.NewEnumElement(0, c.categories, true), nil
+	}
+	return nil, fmt.Errorf("unsupported type, type: %s", t)
+}
+
+// cast converts a non NA element into the type
+func (c castConfig) cast(e element.Element, t Type) (element.Element, error) {
+	switch t {
+	case StringType:
+		s, err := c.toString(e)
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		return element.NewStringElement(s, false), nil
+	case NumericType:
+		if se, ok := e.(element.StringElement); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(se.Value), 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse float")
+			}
+			return element.NewNumericElement(f, false), nil
+		}
+		if _, ok := e.(element.BoolElement); !ok && !isNumber(e) {
+			return nil, fmt.Errorf("element cannot be cast into %s", t)
+		}
+		f, err := e.Float()
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		return element.NewNumericElement(f, false), nil
+	case StringListType:
+		if se, ok := e.(element.StringElement); ok {
+			return element.NewStringListElement(strings.Split(se.Value, c.separator)), nil
+		}
+		s, err := c.toString(e)
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		return element.NewStringListElement([]string{s}), nil
+	case BoolType:
+		switch v := e.(type) {
+		case element.StringElement:
+			b, err := strconv.ParseBool(strings.TrimSpace(v.Value))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse bool")
+			}
+			return element.NewBoolElement(b, false), nil
+		case element.BoolElement:
+			return v, nil
+		}
+		if !isNumber(e) {
+			return nil, fmt.Errorf("element cannot be cast into %s", t)
+		}
+		f, err := e.Float()
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		if f != 0 && f != 1 {
+			return nil, fmt.Errorf("only 0 and 1 can be cast into bool, value: %f", f)
+		}
+		return element.NewBoolElement(f == 1, false), nil
+	case IntegerType:
+		switch v := e.(type) {
+		case element.StringElement:
+			i, err := strconv.ParseInt(strings.TrimSpace(v.Value), 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse int")
+			}
+			return element.NewIntElement(i, false), nil
+		case element.IntElement:
+			return v, nil
+		case element.DecimalElement:
+			integral, err := v.Rescale(0, element.RoundDown)
+			if err != nil {
+				return nil, errors.Wrap(err, "")
+			}
+			if !integral.Equal(v) {
+				return nil, errors.New("value is not an integer")
+			}
+			return element.NewIntElement(integral.Value, false), nil
+		}
+		if _, ok := e.(element.BoolElement); !ok && !isNumber(e) {
+			return nil, fmt.Errorf("element cannot be cast into %s", t)
+		}
+		f, err := e.Float()
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		ie, err := element.NewNumericElement(f, false).ToIntElement()
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		return ie, nil
+	case DatetimeType:
+		switch v := e.(type) {
+		case element.StringElement:
+			te, err := v.ParseTime(c.layout, c.location)
+			if err != nil {
+				return nil, errors.Wrap(err, "")
+			}
+			return te, nil
+		case element.TimeElement:
+			return v, nil
+		}
+		return nil, fmt.Errorf("element cannot be cast into %s", t)
+	case DurationType:
+		switch v := e.(type) {
+		case element.StringElement:
+			d, err := time.ParseDuration(strings.TrimSpace(v.Value))
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse duration")
+			}
+			return element.NewDurationElement(d, false), nil
+		case element.DurationElement:
+			return v, nil
+		}
+		return nil, fmt.Errorf("element cannot be cast into %s", t)
+	case DecimalType:
+		var de element.DecimalElement
+		var err error
+		switch v := e.(type) {
+		case element.StringElement:
+			de, err = element.ParseDecimal(strings.TrimSpace(v.Value), c.scale)
+		case element.DecimalElement:
+			de, err = v.Rescale(c.scale, c.roundingMode)
+		case element.IntElement:
+			de, err = element.NewDecimalElement(v.Value, 0, false).Rescale(c.scale, c.roundingMode)
+		case element.NumericElement:
+			de, err = v.ToDecimalElement(c.scale, c.roundingMode)
+		default:
+			return nil, fmt.Errorf("element cannot be cast into %s", t)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		return de, nil
+	case EnumType:
+		switch e.(type) {
+		case element.StringElement, element.EnumElement:
+			s, err := e.String()
+			if err != nil {
+				return nil, errors.Wrap(err, "")
+			}
+			ee, err := c.categories.NewElement(s)
+			if err != nil {
+				return nil, errors.Wrap(err, "")
+			}
+			return ee, nil
+		}
+		return nil, fmt.Errorf("element cannot be cast into %s", t)
+	}
+	return nil, fmt.Errorf("unsupported type, type: %s", t)
+}
+
+// toString formats a non NA element. Numbers are written in the shortest form which parses back to the same value.
+func (c castConfig) toString(e element.Element) (string, error) {
+	switch v := e.(type) {
+	case element.NumericElement:
+		return strconv.FormatFloat(v.Value, 'f', -1, 64), nil
+	case element.StringListElement:
+		return strings.Join(v, c.separator), nil
+	case element.TimeElement:
+		return v.Format(c.layout).String()
+	}
+	s, err := e.String()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert into string")
+	}
+	return s, nil
+}
+
+func isNumber(e element.Element) bool {
+	switch e.(type) {
+	case element.NumericElement, element.IntElement, element.DecimalElement:
+		return true
+	}
+	return false
+}
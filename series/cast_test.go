@@ -0,0 +1,345 @@
+package series
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+)
+
+func TestSeries_Cast(t *testing.T) {
+	type field struct {
+		Series
+	}
+	type args struct {
+		t       Type
+		options []CastOption
+	}
+	strings := Series{
+		Name: "salary",
+		Elements: element.StringElements{
+			element.StringElement{
+				Value:  "123",
+				IsNull: false,
+			},
+			element.StringElement{
+				Value:  "abc",
+				IsNull: false,
+			},
+			element.StringElement{
+				IsNull: true,
+			},
+		},
+		AggregatedMethod: None,
+	}
+	tests := []struct {
+		name string
+		field
+		args
+		want             Series
+		wantCoercedCount int
+		wantErr          bool
+	}{
+		{
+			name: "pass (lenient string to numeric)",
+			field: field{
+				strings,
+			},
+			args: args{
+				t:       NumericType,
+				options: []CastOption{Lenient()},
+			},
+			want: Series{
+				Name: "salary",
+				Elements: element.NumericArray{
+					Values:   []float64{123, 0, 0},
+					Validity: element.Bitmap{^uint64(1<<1 | 1<<2)},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 1,
+			wantErr:          false,
+		},
+		{
+			name: "fail (strict string to numeric)",
+			field: field{
+				strings,
+			},
+			args: args{
+				t: NumericType,
+			},
+			want:             Series{},
+			wantCoercedCount: 0,
+			wantErr:          true,
+		},
+		{
+			name: "pass (numeric to string)",
+			field: field{
+				Series{
+					Name: "salary",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  1.5,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  100,
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t: StringType,
+			},
+			want: Series{
+				Name: "salary",
+				Elements: element.StringElements{
+					element.StringElement{
+						Value:  "1.5",
+						IsNull: false,
+					},
+					element.StringElement{
+						Value:  "100",
+						IsNull: false,
+					},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 0,
+			wantErr:          false,
+		},
+		{
+			name: "pass (string to string list)",
+			field: field{
+				Series{
+					Name: "tags",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "a|b",
+							IsNull: false,
+						},
+						element.StringElement{
+							IsNull: true,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t:       StringListType,
+				options: []CastOption{WithSeparator("|")},
+			},
+			want: Series{
+				Name: "tags",
+				Elements: element.StringListElements{
+					element.StringListElement{"a", "b"},
+					element.StringListElement{},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 0,
+			wantErr:          false,
+		},
+		{
+			name: "pass (string list to string)",
+			field: field{
+				Series{
+					Name: "tags",
+					Elements: element.StringListElements{
+						element.StringListElement{"a", "b"},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t: StringType,
+			},
+			want: Series{
+				Name: "tags",
+				Elements: element.StringElements{
+					element.StringElement{
+						Value:  "a,b",
+						IsNull: false,
+					},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 0,
+			wantErr:          false,
+		},
+		{
+			name: "pass (numeric to integer)",
+			field: field{
+				Series{
+					Name: "age",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  30,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  30.5,
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t:       IntegerType,
+				options: []CastOption{Lenient()},
+			},
+			want: Series{
+				Name: "age",
+				Elements: element.IntArray{
+					Values:   []int64{30, 0},
+					Validity: element.Bitmap{^uint64(1 << 1)},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 1,
+			wantErr:          false,
+		},
+		{
+			name: "pass (string to datetime)",
+			field: field{
+				Series{
+					Name: "joined",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "2020/04/01",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t:       DatetimeType,
+				options: []CastOption{WithTimeLayout("2006/01/02")},
+			},
+			want: Series{
+				Name: "joined",
+				Elements: element.TimeElements{
+					element.TimeElement{
+						Value:  time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+						IsNull: false,
+					},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 0,
+			wantErr:          false,
+		},
+		{
+			name: "pass (string to decimal)",
+			field: field{
+				Series{
+					Name: "price",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "1,000.5",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t:       DecimalType,
+				options: []CastOption{WithScale(2)},
+			},
+			want: Series{
+				Name: "price",
+				Elements: element.DecimalElements{
+					element.DecimalElement{
+						Value:  100050,
+						Scale:  2,
+						IsNull: false,
+					},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 0,
+			wantErr:          false,
+		},
+		{
+			name: "pass (lenient string to enum)",
+			field: field{
+				Series{
+					Name: "grade",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "A",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "Z",
+							IsNull: false,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			args: args{
+				t: EnumType,
+				options: []CastOption{
+					WithCategories(element.Categories{"S", "A"}),
+					Lenient(),
+				},
+			},
+			want: Series{
+				Name: "grade",
+				Elements: element.EnumElements{
+					Categories: element.Categories{"S", "A"},
+					Codes:      []int32{1, -1},
+				},
+				AggregatedMethod: None,
+			},
+			wantCoercedCount: 1,
+			wantErr:          false,
+		},
+		{
+			name: "fail (decimal without scale)",
+			field: field{
+				strings,
+			},
+			args: args{
+				t: DecimalType,
+			},
+			want:             Series{},
+			wantCoercedCount: 0,
+			wantErr:          true,
+		},
+		{
+			name: "fail (unsupported type)",
+			field: field{
+				strings,
+			},
+			args: args{
+				t: UnknownType,
+			},
+			want:             Series{},
+			wantCoercedCount: 0,
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotCoercedCount, err := tt.field.Series.Cast(tt.args.t, tt.args.options...)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(gotCoercedCount, tt.wantCoercedCount); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
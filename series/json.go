@@ -0,0 +1,136 @@
+package series
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hrbrain/goban/element"
+	"github.com/pkg/errors"
+)
+
+type jsonSeries struct {
+	Name             Name               `json:"name"`
+	Type             Type               `json:"type"`
+	AggregatedMethod AggregationMethod  `json:"aggregated_method"`
+	Categories       element.Categories `json:"categories,omitempty"`
+	Scale            *int32             `json:"scale,omitempty"`
+	Elements         json.RawMessage    `json:"elements"`
+}
+
+// MarshalJSON encodes series with its type and aggregated method so that it can be decoded by UnmarshalJSON.
+// The categories of enum series and the scale of decimal series are encoded as well.
+func (s Series) MarshalJSON() ([]byte, error) {
+	if s.Elements == nil {
+		return nil, errors.New("nil elements are not allowed")
+	}
+	elements, err := json.Marshal(s.Elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal elements")
+	}
+	js := jsonSeries{
+		Name:             s.GetName(),
+		Type:             s.GetType(),
+		AggregatedMethod: s.GetAggregatedMethod(),
+		Elements:         elements,
+	}
+	switch e := s.Elements.(type) {
+	case element.EnumElements:
+		js.Categories = e.Categories
+	case element.DecimalElements:
+		scale := e.Scale()
+		js.Scale = &scale
+	}
+	return json.Marshal(js)
+}
+
+// UnmarshalJSON decodes series encoded by MarshalJSON
+func (s *Series) UnmarshalJSON(data []byte) error {
+	var js jsonSeries
+	if err := json.Unmarshal(data, &js); err != nil {
+		return errors.Wrap(err, "failed to unmarshal series")
+	}
+	var elements element.Elements
+	if js.Type == EnumType {
+		enumElements := element.EnumElements{Categories: js.Categories}
+		if err := json.Unmarshal(js.Elements, &enumElements); err != nil {
+			return errors.Wrap(err, "failed to unmarshal enum elements")
+		}
+		elements = enumElements
+	} else {
+		var err error
+		elements, err = UnmarshalElementsJSON(js.Type, js.Elements)
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal elements")
+		}
+	}
+	if decimalElements, ok := elements.(element.DecimalElements); ok && js.Scale != nil {
+		var err error
+		elements, err = decimalElements.Rescale(*js.Scale)
+		if err != nil {
+			return errors.Wrap(err, "failed to rescale decimal elements")
+		}
+	}
+	newSeries, err := NewSeries(js.Name, elements, js.AggregatedMethod)
+	if err != nil {
+		return errors.Wrap(err, "failed to make new series")
+	}
+	*s = newSeries
+	return nil
+}
+
+// UnmarshalElementsJSON decodes a JSON array into elements of the type.
+// Decimal elements have the largest scale of the values.
+// Enum elements need their categories, so decode them by element.EnumElements.UnmarshalJSON.
+func UnmarshalElementsJSON(t Type, data []byte) (element.Elements, error) {
+	switch t {
+	case StringType:
+		elements := element.StringElements{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal string elements")
+		}
+		return elements, nil
+	case NumericType:
+		elements := element.NumericArray{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal numeric array")
+		}
+		return elements, nil
+	case StringListType:
+		elements := element.StringListElements{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal string list elements")
+		}
+		return elements, nil
+	case IntegerType:
+		elements := element.IntArray{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal int array")
+		}
+		return elements, nil
+	case DatetimeType:
+		elements := element.TimeElements{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal time elements")
+		}
+		return elements, nil
+	case DurationType:
+		elements := element.DurationElements{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal duration elements")
+		}
+		return elements, nil
+	case DecimalType:
+		elements := element.DecimalElements{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal decimal elements")
+		}
+		return elements, nil
+	case BoolType:
+		elements := element.BoolElements{}
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal bool elements")
+		}
+		return elements, nil
+	}
+	return nil, fmt.Errorf("unsupported type, type: %s", t)
+}
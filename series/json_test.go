@@ -0,0 +1,191 @@
+package series
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hrbrain/goban/element"
+)
+
+func TestSeries_MarshalJSON(t *testing.T) {
+	type field struct {
+		Series
+	}
+	tests := []struct {
+		name string
+		field
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			field: field{
+				Series{
+					Name: "tags",
+					Elements: element.StringListElements{
+						element.StringListElement{"a", "b"},
+						element.StringListElement{},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			want:    `{"name":"tags","type":"string_list","aggregated_method":"","elements":[["a","b"],null]}`,
+			wantErr: false,
+		},
+		{
+			name: "pass (decimal with scale)",
+			field: field{
+				Series{
+					Name: "price",
+					Elements: element.DecimalElements{
+						element.DecimalElement{
+							Value:  150,
+							Scale:  2,
+							IsNull: false,
+						},
+						element.DecimalElement{
+							Scale:  2,
+							IsNull: true,
+						},
+					},
+					AggregatedMethod: None,
+				},
+			},
+			want:    `{"name":"price","type":"decimal","aggregated_method":"","scale":2,"elements":["1.50",null]}`,
+			wantErr: false,
+		},
+		{
+			name: "fail (nil elements)",
+			field: field{
+				Series{
+					Name: "tags",
+				},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.field.Series)
+			if diff := cmp.Diff(string(got), tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestSeries_UnmarshalJSON(t *testing.T) {
+	type args struct {
+		data string
+	}
+	tests := []struct {
+		name string
+		args
+		want    Series
+		wantErr bool
+	}{
+		{
+			name: "pass",
+			args: args{
+				data: `{"name":"salary","type":"numeric","aggregated_method":"Mean","elements":[1.5,null]}`,
+			},
+			want: Series{
+				Name: "salary",
+				Elements: element.NumericArray{
+					Values:   []float64{1.5, 0},
+					Validity: element.Bitmap{^uint64(1 << 1)},
+				},
+				AggregatedMethod: Mean,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (enum)",
+			args: args{
+				data: `{"name":"grade","type":"enum","aggregated_method":"","categories":["S","A","B"],"elements":["B",null]}`,
+			},
+			want: Series{
+				Name: "grade",
+				Elements: element.EnumElements{
+					Categories: element.Categories{"S", "A", "B"},
+					Codes:      []int32{2, -1},
+				},
+				AggregatedMethod: None,
+			},
+			wantErr: false,
+		},
+		{
+			name: "pass (decimal of scale)",
+			args: args{
+				data: `{"name":"price","type":"decimal","aggregated_method":"","scale":2,"elements":["1.5",null]}`,
+			},
+			want: Series{
+				Name: "price",
+				Elements: element.DecimalElements{
+					element.DecimalElement{
+						Value:  150,
+						Scale:  2,
+						IsNull: false,
+					},
+					element.DecimalElement{
+						Scale:  2,
+						IsNull: true,
+					},
+				},
+				AggregatedMethod: None,
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail (decimal with more digits than scale)",
+			args: args{
+				data: `{"name":"price","type":"decimal","scale":1,"elements":["1.55"]}`,
+			},
+			want:    Series{},
+			wantErr: true,
+		},
+		{
+			name: "fail (enum value out of categories)",
+			args: args{
+				data: `{"name":"grade","type":"enum","categories":["S","A","B"],"elements":["C"]}`,
+			},
+			want:    Series{},
+			wantErr: true,
+		},
+		{
+			name: "fail (unknown type)",
+			args: args{
+				data: `{"name":"salary","type":"","elements":[]}`,
+			},
+			want:    Series{},
+			wantErr: true,
+		},
+		{
+			name: "fail (type mismatch)",
+			args: args{
+				data: `{"name":"salary","type":"numeric","elements":["a"]}`,
+			},
+			want:    Series{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Series
+			err := json.Unmarshal([]byte(tt.args.data), &got)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package series
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hrbrain/goban/element"
 	"github.com/pkg/errors"
@@ -9,6 +12,9 @@ import (
 	"gonum.org/v1/gonum/stat"
 )
 
+// DecimalRoundingMode is the default rounding mode of Mean aggregation of decimal series
+const DecimalRoundingMode = element.RoundHalfUp
+
 // Series list of same type of elements
 type Series struct {
 	Name             Name
@@ -35,10 +41,22 @@ func (s Series) GetType() Type {
 	switch s.Elements.(type) {
 	case element.StringElements:
 		return StringType
-	case element.NumericElements:
+	case element.NumericElements, element.NumericArray:
 		return NumericType
 	case element.StringListElements:
 		return StringListType
+	case element.BoolElements:
+		return BoolType
+	case element.IntElements, element.IntArray:
+		return IntegerType
+	case element.TimeElements:
+		return DatetimeType
+	case element.DurationElements:
+		return DurationType
+	case element.EnumElements:
+		return EnumType
+	case element.DecimalElements:
+		return DecimalType
 	}
 	return UnknownType
 }
@@ -64,8 +82,18 @@ func (s Series) UpdateElements(elements element.Elements) (Series, error) {
 	return s, nil
 }
 
-func (s Series) AddElement(element element.Element) (Series, error) {
-	elements, err := s.Elements.AddElement(element)
+// AddElement returns a series with the element added. The elements are clipped first,
+// so they are reallocated instead of written into storage which other series share.
+// As this copies the elements, build elements by element.Elements.AddElement and UpdateElements to add many.
+func (s Series) AddElement(e element.Element) (Series, error) {
+	if s.Elements == nil {
+		return Series{}, errors.New("nil elements are not allowed")
+	}
+	elements, err := element.Clip(s.Elements)
+	if err != nil {
+		return Series{}, errors.Wrap(err, "failed to clip elements")
+	}
+	elements, err = elements.AddElement(e)
 	if err != nil {
 		return Series{}, errors.Wrap(err, "failed to add element")
 	}
@@ -77,24 +105,106 @@ func (s Series) Floats() ([]float64, error) {
 	return s.Elements.Floats()
 }
 
-// Mean Calculate the mean of all elements
+// Mean Calculate the mean of all elements skipping NA. It fails if no element is left.
 func (s Series) Mean() (float64, error) {
-	floatsNums, err := s.Floats()
+	floatsNums, err := s.floatsWithoutNA()
 	if err != nil {
-		return 0, errors.Wrap(err, "failed to convert to floats")
+		return 0, errors.Wrap(err, "")
+	}
+	if len(floatsNums) == 0 {
+		return 0, errors.New("series has no element other than NA")
 	}
 	return stat.Mean(floatsNums, nil), nil
 }
 
-// Sum Calculate the sum of all elements
+// Sum Calculate the sum of all elements skipping NA. The sum of no element is 0.
 func (s Series) Sum() (float64, error) {
-	floatsNums, err := s.Floats()
+	floatsNums, err := s.floatsWithoutNA()
 	if err != nil {
-		return 0, errors.Wrap(err, "failed to convert to floats")
+		return 0, errors.Wrap(err, "")
 	}
 	return floats.Sum(floatsNums), nil
 }
 
+// floatsWithoutNA converts elements other than NA to float64 slice
+func (s Series) floatsWithoutNA() ([]float64, error) {
+	if s.CountNA() > 0 {
+		var err error
+		s, err = s.DropNA()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to drop NA")
+		}
+	}
+	floatsNums, err := s.Floats()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert to floats")
+	}
+	return floatsNums, nil
+}
+
+// Quantile Calculate the p-quantile of all elements skipping NA by stat.Quantile with stat.LinInterp,
+// which interpolates linearly between the sorted elements. Median is Quantile of 0.5.
+func (s Series) Quantile(p float64) (float64, error) {
+	// written so that NaN is rejected as well
+	if !(0 <= p && p <= 1) {
+		return 0, fmt.Errorf("quantile must be between 0 and 1, p: %f", p)
+	}
+	floatsNums, err := s.floatsWithoutNA()
+	if err != nil {
+		return 0, errors.Wrap(err, "")
+	}
+	if len(floatsNums) == 0 {
+		return 0, errors.New("series has no element other than NA")
+	}
+	sorted := make([]float64, len(floatsNums))
+	copy(sorted, floatsNums)
+	sort.Float64s(sorted)
+	return stat.Quantile(p, stat.LinInterp, sorted, nil), nil
+}
+
+// Min returns the smallest element. Elements must implement element.Comparer.
+func (s Series) Min() (element.Element, error) {
+	return s.extreme(func(c int) bool { return c < 0 })
+}
+
+// Max returns the largest element. Elements must implement element.Comparer.
+func (s Series) Max() (element.Element, error) {
+	return s.extreme(func(c int) bool { return c > 0 })
+}
+
+// extreme returns the first element e for which replace(e.Compare(current)) holds against all the others
+func (s Series) extreme(replace func(int) bool) (element.Element, error) {
+	var extreme element.Element
+	for i := 0; i < s.Len(); i++ {
+		e, err := s.GetElement(i)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get element")
+		}
+		comparer, ok := e.(element.Comparer)
+		if !ok {
+			return nil, fmt.Errorf("elements cannot be compared, type: %s", s.GetType())
+		}
+		if e.IsNA() {
+			return nil, fmt.Errorf("NA cannot be compared, index: %d", i)
+		}
+		if extreme == nil {
+			extreme = e
+			continue
+		}
+		c, err := comparer.Compare(extreme)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compare elements")
+		}
+		if replace(c) {
+			extreme = e
+		}
+	}
+	if extreme == nil {
+		return nil, errors.New("series is empty")
+	}
+	return extreme, nil
+}
+
 // Split string elements by separator
 func (s Series) Split(separator string, limit int) (Series, error) {
 	stringElements, ok := s.Elements.(element.StringElements)
@@ -110,45 +220,392 @@ func (s Series) Split(separator string, limit int) (Series, error) {
 
 // CanAggregateWith Check if the series can be aggregated with the method
 func (s Series) CanAggregateWith(method AggregationMethod) error {
+	if aggregator, ok := lookupAggregator(method); ok {
+		if aggregator.CanAggregate == nil {
+			return nil
+		}
+		if err := aggregator.CanAggregate(s); err != nil {
+			return errors.Wrapf(err, "you cannot aggregate with this method for this type, method: %s, type: %s", method, s.GetType())
+		}
+		return nil
+	}
 	switch s.GetType() {
 	case StringType:
-		switch method {
-		case Count, None:
+		switch method.kind() {
+		case Count, Size, Min, Max, First, Last, Mode, CountDistinct, Concat, Collect, None:
+			return nil
+		}
+	case StringListType:
+		switch method.kind() {
+		case Count, Size, First, Last, Mode, CountDistinct, None:
+			return nil
+		}
+	case NumericType, IntegerType, DecimalType:
+		switch method.kind() {
+		case Count, Size, Mean, Sum, Min, Max, Median, Std, Var, quantile, First, Last, Mode, CountDistinct, None:
 			return nil
 		}
-	case NumericType:
-		switch method {
-		case Count, Mean, Sum, None:
+	case BoolType:
+		// Sum counts true and Mean is the ratio of true
+		switch method.kind() {
+		case Count, Size, Mean, Sum, First, Last, Mode, CountDistinct, None:
+			return nil
+		}
+	case DatetimeType, DurationType, EnumType:
+		switch method.kind() {
+		case Count, Size, Min, Max, First, Last, Mode, CountDistinct, None:
 			return nil
 		}
 	}
 	return fmt.Errorf("you cannot aggregate with this method for this type, method: %s, type: %s", method, s.GetType())
 }
 
-func (s Series) Aggregate(method AggregationMethod) (element.Element, error) {
+// Aggregate aggregates the series with the method.
+// NA elements are skipped unless PropagateNA is given, and the result is NA if no element is left.
+// Integer series keep integer results for Count, Size, CountDistinct, Sum, Min and Max.
+func (s Series) Aggregate(method AggregationMethod, options ...AggregateOption) (element.Element, error) {
+	config := aggregateConfig{
+		separator:    ",",
+		roundingMode: DecimalRoundingMode,
+	}
+	for _, option := range options {
+		option(&config)
+	}
 
 	if err := s.CanAggregateWith(method); err != nil {
 		return nil, errors.Wrap(err, "")
 	}
+	if aggregator, ok := lookupAggregator(method); ok {
+		return s.aggregateWith(aggregator, config)
+	}
+	var p float64
+	if method.kind() == quantile {
+		var err error
+		p, err = method.QuantileLevel()
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+	}
 
 	switch method {
+	case Size:
+		return s.countElement(s.Len()), nil
 	case Count:
-		return element.NewNumericElement(float64(s.Len()), false), nil
+		return s.countElement(s.Len() - s.CountNA()), nil
+	case CountDistinct:
+		withoutNA, err := s.DropNA()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to drop NA")
+		}
+		values, _ := withoutNA.distinct()
+		return s.countElement(len(values)), nil
+	case None:
+		return s.GetGroupedElement()
+	}
+
+	naCount := s.CountNA()
+	if naCount == s.Len() || (naCount > 0 && config.naPolicy == PropagateNA) {
+		return s.aggregatedNA(method), nil
+	}
+	if naCount > 0 {
+		var err error
+		s, err = s.DropNA()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to drop NA")
+		}
+	}
+
+	switch method.kind() {
 	case Mean:
+		// decimals are averaged exactly and rounded to their scale
+		if decimalElements, ok := s.Elements.(element.DecimalElements); ok {
+			mean, err := decimalElements.Mean(config.roundingMode)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to calculate mean")
+			}
+			return mean, nil
+		}
 		mean, err := s.Mean()
 		if err != nil {
 			return nil, errors.Wrap(err, "")
 		}
 		return element.NewNumericElement(mean, false), nil
 	case Sum:
+		// integers are summed exactly instead of being promoted to float
+		if intElements, ok := s.Elements.(intSummer); ok {
+			sum, err := intElements.Sum()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to sum")
+			}
+			return element.NewIntElement(sum, false), nil
+		}
+		if decimalElements, ok := s.Elements.(element.DecimalElements); ok {
+			sum, err := decimalElements.Sum()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to sum")
+			}
+			return sum, nil
+		}
 		sum, err := s.Sum()
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to sum")
 		}
 		return element.NewNumericElement(sum, false), nil
+	case Min:
+		min, err := s.Min()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get min")
+		}
+		return min, nil
+	case Max:
+		max, err := s.Max()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get max")
+		}
+		return max, nil
+	case First:
+		return s.GetElement(0)
+	case Last:
+		return s.GetElement(s.Len() - 1)
+	case Mode:
+		values, counts := s.distinct()
+		mode := 0
+		for i, count := range counts {
+			if count > counts[mode] {
+				mode = i
+			}
+		}
+		return values[mode], nil
+	case Concat, Collect:
+		strs, err := s.stringValues(config)
+		if err != nil {
+			return nil, errors.Wrap(err, "")
+		}
+		if method == Concat {
+			return element.NewStringElement(strings.Join(strs, config.separator), false), nil
+		}
+		return element.NewStringListElement(strs), nil
+	case Median:
+		median, err := s.Quantile(0.5)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate median")
+		}
+		return element.NewNumericElement(median, false), nil
+	case quantile:
+		q, err := s.Quantile(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate quantile")
+		}
+		return element.NewNumericElement(q, false), nil
+	case Std, Var:
+		// sample statistics are undefined for a single element
+		if s.Len() < 2 {
+			return element.NewNumericElement(0, true), nil
+		}
+		floatsNums, err := s.Floats()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert to floats")
+		}
+		if method == Std {
+			return element.NewNumericElement(stat.StdDev(floatsNums, nil), false), nil
+		}
+		return element.NewNumericElement(stat.Variance(floatsNums, nil), false), nil
 	default:
-		return s.GetGroupedElement()
+		return nil, fmt.Errorf("unsupported aggregation method, method: %s", method)
+	}
+}
+
+// countElement returns the count as an element. Integer series keep integer results.
+func (s Series) countElement(count int) element.Element {
+	if s.GetType() == IntegerType {
+		return element.NewIntElement(int64(count), false)
+	}
+	return element.NewNumericElement(float64(count), false)
+}
+
+// aggregatedNA returns NA of the type which the method results in
+func (s Series) aggregatedNA(method AggregationMethod) element.Element {
+	switch method {
+	case Min, Max, First, Last, Mode, Concat:
+		return s.NAElement()
+	case Collect:
+		return element.NewStringListElement(nil)
+	case Sum:
+		switch s.Elements.(type) {
+		case element.IntElements, element.IntArray, element.DecimalElements:
+			return s.NAElement()
+		}
+	case Mean:
+		if _, ok := s.Elements.(element.DecimalElements); ok {
+			return s.NAElement()
+		}
+	}
+	return element.NewNumericElement(0, true)
+}
+
+// NAElement returns NA of the type of the series
+func (s Series) NAElement() element.Element {
+	switch elements := s.Elements.(type) {
+	case element.StringElements:
+		return element.NewStringElement("", true)
+	case element.StringListElements:
+		return element.NewStringListElement(nil)
+	case element.BoolElements:
+		return element.NewBoolElement(false, true)
+	case element.IntElements, element.IntArray:
+		return element.NewIntElement(0, true)
+	case element.TimeElements:
+		return element.NewTimeElement(time.Time{}, true)
+	case element.DurationElements:
+		return element.NewDurationElement(0, true)
+	case element.EnumElements:
+		return element.NewEnumElement(0, elements.Categories, true)
+	case element.DecimalElements:
+		var scale int32
+		if len(elements) > 0 {
+			scale = elements[0].Scale
+		}
+		return element.NewDecimalElement(0, scale, true)
+	}
+	return element.NewNumericElement(0, true)
+}
+
+// stringValues returns the values of string elements deduplicated and sorted as configured
+func (s Series) stringValues(config aggregateConfig) ([]string, error) {
+	stringElements, ok := s.Elements.(element.StringElements)
+	if !ok {
+		return nil, errors.New("this series is not string elements")
+	}
+	strs := make([]string, 0, len(stringElements))
+	seen := map[string]bool{}
+	for _, se := range stringElements {
+		if config.distinct && seen[se.Value] {
+			continue
+		}
+		seen[se.Value] = true
+		strs = append(strs, se.Value)
+	}
+	if config.sorted {
+		sort.Strings(strs)
+	}
+	return strs, nil
+}
+
+// distinct returns the distinct elements in order of appearance and how many times each appears.
+// Elements are hashed by element.Key, which is consistent with Equal.
+func (s Series) distinct() ([]element.Element, []int) {
+	var values []element.Element
+	var counts []int
+	indexes := map[string]int{}
+	for i := 0; i < s.Len(); i++ {
+		e, err := s.GetElement(i)
+		if err != nil {
+			continue
+		}
+		key := element.Key(e)
+		if j, ok := indexes[key]; ok {
+			counts[j]++
+			continue
+		}
+		indexes[key] = len(values)
+		values = append(values, e)
+		counts = append(counts, 1)
+	}
+	return values, counts
+}
+
+// intSummer is implemented by integer elements which are summed exactly
+type intSummer interface {
+	Sum() (int64, error)
+}
+
+// array is implemented by elements stored with a validity bitmap, such as element.NumericArray and element.IntArray.
+// Their NA is read without boxing values into elements.
+type array interface {
+	IsNA(index int) bool
+	NACount() int
+}
+
+// CountNA returns the number of NA elements
+func (s Series) CountNA() int {
+	if a, ok := s.Elements.(array); ok {
+		return a.NACount()
+	}
+	count := 0
+	for i := 0; i < s.Len(); i++ {
+		e, err := s.GetElement(i)
+		if err == nil && e.IsNA() {
+			count++
+		}
+	}
+	return count
+}
+
+// DropNA make a series without NA elements
+func (s Series) DropNA() (Series, error) {
+	var indexes []int
+	if a, ok := s.Elements.(array); ok {
+		// the validity is read without boxing values into elements
+		for i := 0; i < s.Len(); i++ {
+			if !a.IsNA(i) {
+				indexes = append(indexes, i)
+			}
+		}
+		return s.Take(indexes)
+	}
+	for i := 0; i < s.Len(); i++ {
+		e, err := s.GetElement(i)
+		if err != nil {
+			return Series{}, errors.Wrap(err, "failed to get element")
+		}
+		if !e.IsNA() {
+			indexes = append(indexes, i)
+		}
+	}
+	return s.Take(indexes)
+}
+
+// Take make a series with elements at the indexes
+func (s Series) Take(indexes []int) (Series, error) {
+	switch a := s.Elements.(type) {
+	case element.NumericArray:
+		taken, err := a.Take(indexes)
+		if err != nil {
+			return Series{}, errors.Wrap(err, "failed to take values")
+		}
+		return s.UpdateElements(taken)
+	case element.IntArray:
+		taken, err := a.Take(indexes)
+		if err != nil {
+			return Series{}, errors.Wrap(err, "failed to take values")
+		}
+		return s.UpdateElements(taken)
+	}
+	elements := s.Elements.Delete()
+	for _, index := range indexes {
+		e, err := s.GetElement(index)
+		if err != nil {
+			return Series{}, errors.Wrap(err, "failed to get element")
+		}
+		elements, err = elements.AddElement(e)
+		if err != nil {
+			return Series{}, errors.Wrap(err, "failed to add element")
+		}
 	}
+	return s.UpdateElements(elements)
+}
+
+// Clone returns a copy of the series which does not share its elements
+func (s Series) Clone() (Series, error) {
+	if s.Elements == nil {
+		return s, nil
+	}
+	elements, err := element.Clone(s.Elements)
+	if err != nil {
+		return Series{}, errors.Wrap(err, "failed to clone elements")
+	}
+	s.Elements = elements
+	return s, nil
 }
 
 // Delete delete elements with keeping its schema
@@ -167,7 +624,12 @@ func (s Series) Append(s2 Series) (Series, error) {
 	if s.GetAggregatedMethod() != s2.GetAggregatedMethod() {
 		return Series{}, errors.New("series aggregated method is different")
 	}
-	elements, err := s.Elements.Append(s2.Elements)
+	// elements are clipped so that appending reallocates them instead of writing into storage other series share
+	elements, err := element.Clip(s.Elements)
+	if err != nil {
+		return Series{}, errors.Wrap(err, "failed to clip elements")
+	}
+	elements, err = elements.Append(s2.Elements)
 	if err != nil {
 		return Series{}, errors.Wrap(err, "failed to append elements")
 	}
@@ -197,6 +659,54 @@ func (s Series) Join(separator string) (Series, error) {
 	return s.UpdateElements(newStringElements)
 }
 
+// ParseTime parse string elements into time elements by the layout
+func (s Series) ParseTime(layout string, loc *time.Location) (Series, error) {
+	stringElements, ok := s.Elements.(element.StringElements)
+	if !ok {
+		return Series{}, errors.New("this series is not string elements")
+	}
+	timeElements, err := stringElements.ParseTime(layout, loc)
+	if err != nil {
+		return Series{}, errors.Wrap(err, "failed to parse elements")
+	}
+	return s.UpdateElements(timeElements)
+}
+
+// FormatTime format time elements into string elements by the layout
+func (s Series) FormatTime(layout string) (Series, error) {
+	timeElements, ok := s.Elements.(element.TimeElements)
+	if !ok {
+		return Series{}, errors.New("this series is not time elements")
+	}
+	return s.UpdateElements(timeElements.Format(layout))
+}
+
+// ParseDecimal parse string elements into decimal elements of the scale
+func (s Series) ParseDecimal(scale int32) (Series, error) {
+	stringElements, ok := s.Elements.(element.StringElements)
+	if !ok {
+		return Series{}, errors.New("this series is not string elements")
+	}
+	decimalElements, err := stringElements.ParseDecimal(scale)
+	if err != nil {
+		return Series{}, errors.Wrap(err, "failed to parse elements")
+	}
+	return s.UpdateElements(decimalElements)
+}
+
+// ToEnum convert string elements into enum elements of the categories
+func (s Series) ToEnum(categories element.Categories) (Series, error) {
+	stringElements, ok := s.Elements.(element.StringElements)
+	if !ok {
+		return Series{}, errors.New("this series is not string elements")
+	}
+	enumElements, err := stringElements.ToEnumElements(categories)
+	if err != nil {
+		return Series{}, errors.Wrap(err, "failed to convert elements")
+	}
+	return s.UpdateElements(enumElements)
+}
+
 func (s Series) GetAggregatedMethod() AggregationMethod {
 	return s.AggregatedMethod
 }
@@ -1,7 +1,9 @@
 package series
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hrbrain/goban/element"
@@ -150,6 +152,44 @@ func TestSeries_Mean(t *testing.T) {
 			want:      4,
 			wantError: false,
 		},
+		{
+			name: "pass (NA is skipped)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+						element.NumericElement{
+							IsNull: true,
+						},
+						element.NumericElement{
+							Value:  5,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			want:      4,
+			wantError: false,
+		},
+		{
+			name: "fail (all NA)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							IsNull: true,
+						},
+					},
+				},
+			},
+			want:      0,
+			wantError: true,
+		},
 		{
 			name: "fail (string type)",
 			field: field{
@@ -224,6 +264,44 @@ func TestSeries_Sum(t *testing.T) {
 			want:      12,
 			wantError: false,
 		},
+		{
+			name: "pass (NA is skipped)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+						element.NumericElement{
+							IsNull: true,
+						},
+						element.NumericElement{
+							Value:  6,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			want:      9,
+			wantError: false,
+		},
+		{
+			name: "pass (all NA)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							IsNull: true,
+						},
+					},
+				},
+			},
+			want:      0,
+			wantError: false,
+		},
 		{
 			name: "fail (string type)",
 			field: field{
@@ -429,7 +507,69 @@ func TestSeries_Aggregate(t *testing.T) {
 		Series
 	}
 	type args struct {
-		method AggregationMethod
+		method  AggregationMethod
+		options []AggregateOption
+	}
+	salaries := Series{
+		Name: "test",
+		Elements: element.IntElements{
+			element.IntElement{
+				Value:  400,
+				IsNull: false,
+			},
+			element.IntElement{
+				Value:  100,
+				IsNull: false,
+			},
+			element.IntElement{
+				Value:  300,
+				IsNull: false,
+			},
+			element.IntElement{
+				Value:  200,
+				IsNull: false,
+			},
+		},
+	}
+	answers := Series{
+		Name: "test",
+		Elements: element.StringElements{
+			element.StringElement{
+				IsNull: true,
+			},
+			element.StringElement{
+				Value:  "yes",
+				IsNull: false,
+			},
+			element.StringElement{
+				Value:  "no",
+				IsNull: false,
+			},
+			element.StringElement{
+				Value:  "yes",
+				IsNull: false,
+			},
+			element.StringElement{
+				Value:  "no",
+				IsNull: false,
+			},
+		},
+	}
+	withNA := Series{
+		Name: "test",
+		Elements: element.NumericElements{
+			element.NumericElement{
+				Value:  1,
+				IsNull: false,
+			},
+			element.NumericElement{
+				IsNull: true,
+			},
+			element.NumericElement{
+				Value:  3,
+				IsNull: false,
+			},
+		},
 	}
 	tests := []struct {
 		name string
@@ -439,151 +579,920 @@ func TestSeries_Aggregate(t *testing.T) {
 		wantError bool
 	}{
 		{
-			name: "pass (count)",
+			name: "pass (count)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Count,
+			},
+			want: element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Mean,
+			},
+			want: element.NumericElement{
+				Value:  3,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean of numeric array with NA)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericArray{
+						Values:   []float64{1, 0, 3},
+						Validity: element.Bitmap{^uint64(1 << 1)},
+					},
+				},
+			},
+			args: args{
+				method: Mean,
+			},
+			want: element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (sum)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+						element.NumericElement{
+							Value:  3,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Sum,
+			},
+			want: element.NumericElement{
+				Value:  6,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (sum of bool)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.BoolElements{
+						element.BoolElement{
+							Value:  true,
+							IsNull: false,
+						},
+						element.BoolElement{
+							Value:  false,
+							IsNull: false,
+						},
+						element.BoolElement{
+							Value:  true,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Sum,
+			},
+			want: element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean of bool)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.BoolElements{
+						element.BoolElement{
+							Value:  true,
+							IsNull: false,
+						},
+						element.BoolElement{
+							Value:  false,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Mean,
+			},
+			want: element.NumericElement{
+				Value:  0.5,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (sum of integer)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.IntElements{
+						element.IntElement{
+							Value:  9007199254740993,
+							IsNull: false,
+						},
+						element.IntElement{
+							Value:  2,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Sum,
+			},
+			want: element.IntElement{
+				Value:  9007199254740995,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean of integer)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.IntElements{
+						element.IntElement{
+							Value:  1,
+							IsNull: false,
+						},
+						element.IntElement{
+							Value:  2,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Mean,
+			},
+			want: element.NumericElement{
+				Value:  1.5,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (count of integer)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Count,
+			},
+			want: element.IntElement{
+				Value:  4,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (size of integer)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Size,
+			},
+			want: element.IntElement{
+				Value:  4,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (count distinct of integer)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: CountDistinct,
+			},
+			want: element.IntElement{
+				Value:  4,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (min of integer)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Min,
+			},
+			want: element.IntElement{
+				Value:  100,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (max of integer)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Max,
+			},
+			want: element.IntElement{
+				Value:  400,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (sum of int array skipping NA)",
+			field: field{
+				Series{
+					Name: "salary",
+					Elements: element.IntArray{
+						Values:   []int64{1 << 53, 0, 1},
+						Validity: element.Bitmap{^uint64(1 << 1)},
+					},
+				},
+			},
+			args: args{
+				method: Sum,
+			},
+			want: element.IntElement{
+				Value:  1<<53 + 1,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (count of int array)",
+			field: field{
+				Series{
+					Name: "salary",
+					Elements: element.IntArray{
+						Values:   []int64{1, 0, 1},
+						Validity: element.Bitmap{^uint64(1 << 1)},
+					},
+				},
+			},
+			args: args{
+				method: Count,
+			},
+			want: element.IntElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (min of datetime)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.TimeElements{
+						element.TimeElement{
+							Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+							IsNull: false,
+						},
+						element.TimeElement{
+							Value:  time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Min,
+			},
+			want: element.TimeElement{
+				Value:  time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (max of duration)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.DurationElements{
+						element.DurationElement{
+							Value:  time.Hour,
+							IsNull: false,
+						},
+						element.DurationElement{
+							Value:  2 * time.Hour,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Max,
+			},
+			want: element.DurationElement{
+				Value:  2 * time.Hour,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (max of datetime skips NA)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.TimeElements{
+						element.TimeElement{
+							Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+							IsNull: false,
+						},
+						element.TimeElement{
+							IsNull: true,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Max,
+			},
+			want: element.TimeElement{
+				Value:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (max of enum)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.EnumElements{
+						Categories: element.Categories{"S", "A", "B"},
+						Codes:      []int32{2, 0, 1},
+					},
+				},
+			},
+			args: args{
+				method: Max,
+			},
+			want: element.EnumElement{
+				Code:       2,
+				Categories: element.Categories{"S", "A", "B"},
+				IsNull:     false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (sum of decimal)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.DecimalElements{
+						element.DecimalElement{
+							Value:  10,
+							Scale:  2,
+							IsNull: false,
+						},
+						element.DecimalElement{
+							Value:  20,
+							Scale:  2,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Sum,
+			},
+			want: element.DecimalElement{
+				Value:  30,
+				Scale:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean of decimal)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.DecimalElements{
+						element.DecimalElement{
+							Value:  1,
+							Scale:  2,
+							IsNull: false,
+						},
+						element.DecimalElement{
+							Value:  2,
+							Scale:  2,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Mean,
+			},
+			want: element.DecimalElement{
+				Value:  2,
+				Scale:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean of decimal rounded down)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.DecimalElements{
+						element.DecimalElement{
+							Value:  1,
+							Scale:  2,
+							IsNull: false,
+						},
+						element.DecimalElement{
+							Value:  2,
+							Scale:  2,
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method:  Mean,
+				options: []AggregateOption{WithMeanRoundingMode(element.RoundDown)},
+			},
+			want: element.DecimalElement{
+				Value:  1,
+				Scale:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (none)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "a",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "a",
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: None,
+			},
+			want: element.StringElement{
+				Value:  "a",
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "fail (not grouped)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "a",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "b",
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: None,
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "fail (invalid aggregation method)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.StringElements{
+						element.StringElement{
+							Value:  "a",
+							IsNull: false,
+						},
+						element.StringElement{
+							Value:  "b",
+							IsNull: false,
+						},
+					},
+				},
+			},
+			args: args{
+				method: Mean,
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "pass (mean skips NA)",
+			field: field{
+				withNA,
+			},
+			args: args{
+				method: Mean,
+			},
+			want: element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mean propagates NA)",
+			field: field{
+				withNA,
+			},
+			args: args{
+				method:  Mean,
+				options: []AggregateOption{WithNAPolicy(PropagateNA)},
+			},
+			want: element.NumericElement{
+				IsNull: true,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (count excludes NA)",
+			field: field{
+				withNA,
+			},
+			args: args{
+				method: Count,
+			},
+			want: element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (size includes NA)",
+			field: field{
+				withNA,
+			},
+			args: args{
+				method: Size,
+			},
+			want: element.NumericElement{
+				Value:  3,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (sum of all NA integers)",
 			field: field{
 				Series{
 					Name: "test",
-					Elements: element.NumericElements{
-						element.NumericElement{
-							Value:  3,
-							IsNull: false,
-						},
-						element.NumericElement{
-							Value:  3,
-							IsNull: false,
+					Elements: element.IntElements{
+						element.IntElement{
+							IsNull: true,
 						},
 					},
 				},
 			},
 			args: args{
-				method: Count,
+				method: Sum,
+			},
+			want: element.IntElement{
+				IsNull: true,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (median of even count)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Median,
 			},
 			want: element.NumericElement{
-				Value:  2,
+				Value:  200,
 				IsNull: false,
 			},
 			wantError: false,
 		},
 		{
-			name: "pass (mean)",
+			name: "pass (quantile)",
 			field: field{
-				Series{
-					Name: "test",
-					Elements: element.NumericElements{
-						element.NumericElement{
-							Value:  3,
-							IsNull: false,
-						},
-						element.NumericElement{
-							Value:  3,
-							IsNull: false,
-						},
-					},
-				},
+				salaries,
 			},
 			args: args{
-				method: Mean,
+				method: Quantile(0.25),
 			},
 			want: element.NumericElement{
-				Value:  3,
+				Value:  100,
 				IsNull: false,
 			},
 			wantError: false,
 		},
 		{
-			name: "pass (sum)",
+			name: "pass (var)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Var,
+			},
+			want: element.NumericElement{
+				Value:  16666.666666666668,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (std of single element)",
 			field: field{
 				Series{
 					Name: "test",
-					Elements: element.NumericElements{
-						element.NumericElement{
-							Value:  3,
-							IsNull: false,
-						},
-						element.NumericElement{
-							Value:  3,
+					Elements: element.IntElements{
+						element.IntElement{
+							Value:  1,
 							IsNull: false,
 						},
 					},
 				},
 			},
 			args: args{
-				method: Sum,
+				method: Std,
 			},
 			want: element.NumericElement{
-				Value:  6,
-				IsNull: false,
+				IsNull: true,
 			},
 			wantError: false,
 		},
 		{
-			name: "pass (none)",
+			name: "pass (min of string)",
 			field: field{
 				Series{
 					Name: "test",
 					Elements: element.StringElements{
 						element.StringElement{
-							Value:  "a",
+							Value:  "banana",
 							IsNull: false,
 						},
 						element.StringElement{
-							Value:  "a",
+							Value:  "apple",
 							IsNull: false,
 						},
 					},
 				},
 			},
 			args: args{
-				method: None,
+				method: Min,
 			},
 			want: element.StringElement{
-				Value:  "a",
+				Value:  "apple",
 				IsNull: false,
 			},
 			wantError: false,
 		},
 		{
-			name: "fail (not grouped)",
+			name: "fail (quantile out of range)",
+			field: field{
+				salaries,
+			},
+			args: args{
+				method: Quantile(1.5),
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "fail (median of string)",
 			field: field{
 				Series{
 					Name: "test",
 					Elements: element.StringElements{
 						element.StringElement{
-							Value:  "a",
-							IsNull: false,
-						},
-						element.StringElement{
-							Value:  "b",
+							Value:  "apple",
 							IsNull: false,
 						},
 					},
 				},
 			},
 			args: args{
-				method: None,
+				method: Median,
 			},
 			want:      nil,
 			wantError: true,
 		},
 		{
-			name: "fail (invalid aggregation method)",
+			name: "pass (first skips NA)",
+			field: field{
+				answers,
+			},
+			args: args{
+				method: First,
+			},
+			want: element.StringElement{
+				Value:  "yes",
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (last)",
+			field: field{
+				answers,
+			},
+			args: args{
+				method: Last,
+			},
+			want: element.StringElement{
+				Value:  "no",
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mode breaks ties by first appearance)",
+			field: field{
+				answers,
+			},
+			args: args{
+				method: Mode,
+			},
+			want: element.StringElement{
+				Value:  "yes",
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (count distinct excludes NA)",
+			field: field{
+				answers,
+			},
+			args: args{
+				method: CountDistinct,
+			},
+			want: element.NumericElement{
+				Value:  2,
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (mode of string list)",
 			field: field{
 				Series{
 					Name: "test",
-					Elements: element.StringElements{
-						element.StringElement{
-							Value:  "a",
-							IsNull: false,
+					Elements: element.StringListElements{
+						element.StringListElement{"a"},
+						element.StringListElement{"a", "b"},
+						element.StringListElement{"a", "b"},
+					},
+				},
+			},
+			args: args{
+				method: Mode,
+			},
+			want:      element.StringListElement{"a", "b"},
+			wantError: false,
+		},
+		{
+			name: "pass (first of all NA)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.NumericElements{
+						element.NumericElement{
+							IsNull: true,
 						},
+					},
+				},
+			},
+			args: args{
+				method: First,
+			},
+			want: element.NumericElement{
+				IsNull: true,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (concat with separator)",
+			field: field{
+				answers,
+			},
+			args: args{
+				method:  Concat,
+				options: []AggregateOption{WithConcatSeparator(" / ")},
+			},
+			want: element.StringElement{
+				Value:  "yes / no / yes / no",
+				IsNull: false,
+			},
+			wantError: false,
+		},
+		{
+			name: "pass (collect distinct and sorted)",
+			field: field{
+				answers,
+			},
+			args: args{
+				method:  Collect,
+				options: []AggregateOption{Distinct(), Sorted()},
+			},
+			want:      element.StringListElement{"no", "yes"},
+			wantError: false,
+		},
+		{
+			name: "pass (collect of all NA)",
+			field: field{
+				Series{
+					Name: "test",
+					Elements: element.StringElements{
 						element.StringElement{
-							Value:  "b",
-							IsNull: false,
+							IsNull: true,
 						},
 					},
 				},
 			},
 			args: args{
-				method: Mean,
+				method: Collect,
+			},
+			want:      element.StringListElement(nil),
+			wantError: false,
+		},
+		{
+			name: "fail (concat of numeric)",
+			field: field{
+				withNA,
+			},
+			args: args{
+				method: Concat,
 			},
 			want:      nil,
 			wantError: true,
@@ -592,7 +1501,7 @@ func TestSeries_Aggregate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := tt.field.Aggregate(tt.args.method)
+			got, err := tt.field.Aggregate(tt.args.method, tt.args.options...)
 			if diff := cmp.Diff(got, tt.want); diff != "" {
 				t.Error(diff)
 			}
@@ -748,6 +1657,22 @@ func TestSeries_AddElement(t *testing.T) {
 			want:      Series{},
 			wantError: true,
 		},
+		{
+			name: "fail (nil elements)",
+			field: field{
+				series: Series{
+					Name: "test",
+				},
+			},
+			args: args{
+				element: element.NumericElement{
+					Value:  2,
+					IsNull: false,
+				},
+			},
+			want:      Series{},
+			wantError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -764,6 +1689,123 @@ func TestSeries_AddElement(t *testing.T) {
 	}
 }
 
+func TestSeries_AddElement_SharedElements(t *testing.T) {
+	elements := make(element.NumericElements, 1, 4)
+	elements[0] = element.NewNumericElement(1, false)
+	s := Series{
+		Name:             "test",
+		Elements:         elements,
+		AggregatedMethod: None,
+	}
+	s1, err := s.AddElement(element.NewNumericElement(2, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := s.AddElement(element.NewNumericElement(3, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s3, err := s.Append(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(s1.Elements, element.NumericElements{{Value: 1}, {Value: 2}}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(s2.Elements, element.NumericElements{{Value: 1}, {Value: 3}}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(s3.Elements, element.NumericElements{{Value: 1}, {Value: 1}}); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(elements[:cap(elements)], element.NumericElements{{Value: 1}, {}, {}, {}}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSeries_Clone(t *testing.T) {
+	s := Series{
+		Name:             "test",
+		Elements:         element.NumericElements{{Value: 1}, {IsNull: true}},
+		AggregatedMethod: Sum,
+	}
+	got, err := s.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, s); diff != "" {
+		t.Error(diff)
+	}
+	got.Elements.(element.NumericElements)[0].Value = 2
+	if diff := cmp.Diff(s.Elements, element.NumericElements{{Value: 1}, {IsNull: true}}); diff != "" {
+		t.Error(diff)
+	}
+	got, err = Series{Name: "test"}.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, Series{Name: "test"}); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestSeries_DropNA(t *testing.T) {
+	tests := []struct {
+		name        string
+		series      Series
+		want        Series
+		wantNACount int
+		wantErr     bool
+	}{
+		{
+			name: "pass (numeric elements)",
+			series: Series{
+				Name:     "test",
+				Elements: element.NumericElements{{Value: 1}, {IsNull: true}, {Value: 3}},
+			},
+			want: Series{
+				Name:     "test",
+				Elements: element.NumericElements{{Value: 1}, {Value: 3}},
+			},
+			wantNACount: 1,
+			wantErr:     false,
+		},
+		{
+			name: "pass (numeric array)",
+			series: Series{
+				Name: "test",
+				Elements: element.NumericArray{
+					Values:   []float64{1, 0, 3},
+					Validity: element.Bitmap{^uint64(1 << 1)},
+				},
+			},
+			want: Series{
+				Name: "test",
+				Elements: element.NumericArray{
+					Values: []float64{1, 3},
+				},
+			},
+			wantNACount: 1,
+			wantErr:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.series.CountNA(), tt.wantNACount); diff != "" {
+				t.Error(diff)
+			}
+			got, err := tt.series.DropNA()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(err != nil, tt.wantErr); diff != "" {
+				t.Error(diff)
+				t.Log(err)
+			}
+		})
+	}
+}
+
 func TestSeries_UpdateElements(t *testing.T) {
 	type field struct {
 		series Series
@@ -988,3 +2030,24 @@ func TestSeries_Delete(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkSeries_Aggregate_CountDistinct(b *testing.B) {
+	for _, n := range []int{10000, 20000, 40000} {
+		ids := make(element.StringElements, n)
+		for i := range ids {
+			ids[i] = element.NewStringElement(fmt.Sprintf("employee_%d", i), false)
+		}
+		s := Series{
+			Name:             "employee_id",
+			Elements:         ids,
+			AggregatedMethod: None,
+		}
+		b.Run(fmt.Sprintf("distinct=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := s.Aggregate(CountDistinct); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -8,4 +8,9 @@ const (
 	StringListType Type = "string_list"
 	NumericType    Type = "numeric"
 	EnumType       Type = "enum"
+	BoolType       Type = "bool"
+	IntegerType    Type = "integer"
+	DatetimeType   Type = "datetime"
+	DurationType   Type = "duration"
+	DecimalType    Type = "decimal"
 )